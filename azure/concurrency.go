@@ -0,0 +1,21 @@
+package azure
+
+import (
+	"github.com/turbot/steampipe-plugin-sdk/v5/rate_limiter"
+)
+
+// Maximum number of concurrent diagnostic settings API calls per connection
+const diagnosticSettingsMaxConcurrency = 10
+
+// diagnosticSettingsRateLimiter limits the concurrent calls of the hydrate
+// functions tagged with the diagnosticSettings/list action. The SDK does not
+// apply HydrateConfig.MaxConcurrency on its own, so this limiter enforces it.
+// It can be overridden with a limiter block of the same name in the plugin config.
+func diagnosticSettingsRateLimiter() *rate_limiter.Definition {
+	return &rate_limiter.Definition{
+		Name:           "azure_diagnostic_settings",
+		MaxConcurrency: diagnosticSettingsMaxConcurrency,
+		Scope:          []string{rate_limiter.RateLimiterScopeConnection},
+		Where:          "action = 'diagnosticSettings/list'",
+	}
+}
@@ -128,6 +128,14 @@ func getMonitoringStartDateForGranularity(granularity string) string {
 }
 
 func listAzureMonitorMetricStatistics(ctx context.Context, d *plugin.QueryData, granularity string, metricNameSpace string, metricNames string, dimensionValue string) (interface{}, error) {
+	return listAzureMonitorMetricStatisticsByDimension(ctx, d, granularity, metricNameSpace, metricNames, dimensionValue, "", "")
+}
+
+// listAzureMonitorMetricStatisticsByDimension splits the metrics of a resource by
+// the given dimension, e.g. the event hubs of an Event Hub namespace. The
+// dimension value of each time series is appended to the resource ID under
+// childPath, so that the last path element of DimensionValue is the child name.
+func listAzureMonitorMetricStatisticsByDimension(ctx context.Context, d *plugin.QueryData, granularity string, metricNameSpace string, metricNames string, dimensionValue string, dimensionName string, childPath string) (interface{}, error) {
 	session, err := GetNewSession(ctx, d, "MANAGEMENT")
 	if err != nil {
 		return nil, err
@@ -144,6 +152,9 @@ func listAzureMonitorMetricStatistics(ctx context.Context, d *plugin.QueryData,
 	orderBy := "timestamp"
 	top := int32(1000) // Maximum number of record fetch with given interval
 	filter := ""
+	if dimensionName != "" {
+		filter = dimensionName + " eq '*'"
+	}
 
 	result, err := monitoringClient.List(ctx, dimensionValue, timeSpan, &interval, metricNames, aggregation, &top, orderBy, filter, insights.ResultTypeData, metricNameSpace)
 	if err != nil {
@@ -151,10 +162,18 @@ func listAzureMonitorMetricStatistics(ctx context.Context, d *plugin.QueryData,
 	}
 	for _, metric := range *result.Value {
 		for _, timeseries := range *metric.Timeseries {
+			seriesDimensionValue := dimensionValue
+			if dimensionName != "" && timeseries.Metadatavalues != nil {
+				for _, metadata := range *timeseries.Metadatavalues {
+					if metadata.Name != nil && metadata.Name.Value != nil && strings.EqualFold(*metadata.Name.Value, dimensionName) && metadata.Value != nil {
+						seriesDimensionValue = dimensionValue + "/" + childPath + "/" + *metadata.Value
+					}
+				}
+			}
 			for _, data := range *timeseries.Data {
 				if data.Average != nil {
 					d.StreamListItem(ctx, &monitoringMetric{
-						DimensionValue: dimensionValue,
+						DimensionValue: seriesDimensionValue,
 						TimeStamp:      data.TimeStamp.Format(time.RFC3339),
 						Maximum:        data.Maximum,
 						Minimum:        data.Minimum,
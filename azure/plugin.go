@@ -5,6 +5,7 @@ import (
 
 	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
 	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+	"github.com/turbot/steampipe-plugin-sdk/v5/rate_limiter"
 )
 
 const pluginName = "steampipe-plugin-azure"
@@ -29,6 +30,9 @@ func Plugin(ctx context.Context) *plugin.Plugin {
 				Hydrate: getSubscriptionIdForConnection,
 			},
 		},
+		RateLimiters: []*rate_limiter.Definition{
+			diagnosticSettingsRateLimiter(),
+		},
 		ConnectionConfigSchema: &plugin.ConnectionConfigSchema{
 			NewInstance: ConfigInstance,
 		},
@@ -36,35 +40,57 @@ func Plugin(ctx context.Context) *plugin.Plugin {
 			"azure_ad_group":                                               tableAzureAdGroup(ctx),
 			"azure_ad_service_principal":                                   tableAzureAdServicePrincipal(ctx),
 			"azure_ad_user":                                                tableAzureAdUser(ctx),
+			"azure_aks_maintenance_configuration":                          tableAzureAKSMaintenanceConfiguration(ctx),
 			"azure_alert_management":                                       tableAzureAlertMangement(ctx),
 			"azure_api_management":                                         tableAzureAPIManagement(ctx),
 			"azure_api_management_backend":                                 tableAzureAPIManagementBackend(ctx),
+			"azure_api_management_logger":                                  tableAzureAPIManagementLogger(ctx),
+			"azure_api_management_subscription":                            tableAzureAPIManagementSubscription(ctx),
 			"azure_app_configuration":                                      tableAzureAppConfiguration(ctx),
+			"azure_app_service_certificate":                                tableAzureAppServiceCertificate(ctx),
+			"azure_app_service_domain":                                     tableAzureAppServiceDomain(ctx),
 			"azure_app_service_environment":                                tableAzureAppServiceEnvironment(ctx),
 			"azure_app_service_function_app":                               tableAzureAppServiceFunctionApp(ctx),
+			"azure_app_service_metric_http4xx_hourly":                      tableAzureAppServiceMetricHttp4xxHourly(ctx),
+			"azure_app_service_metric_http5xx_hourly":                      tableAzureAppServiceMetricHttp5xxHourly(ctx),
+			"azure_app_service_metric_requests_hourly":                     tableAzureAppServiceMetricRequestsHourly(ctx),
 			"azure_app_service_plan":                                       tableAzureAppServicePlan(ctx),
 			"azure_app_service_web_app":                                    tableAzureAppServiceWebApp(ctx),
 			"azure_app_service_web_app_slot":                               tableAzureAppServiceWebAppSlot(ctx),
 			"azure_application_gateway":                                    tableAzureApplicationGateway(ctx),
 			"azure_application_insight":                                    tableAzureApplicationInsight(ctx),
+			"azure_application_insights_api_key":                           tableAzureApplicationInsightsAPIKey(ctx),
+			"azure_application_insights_web_test":                          tableAzureApplicationInsightsWebTest(ctx),
 			"azure_application_security_group":                             tableAzureApplicationSecurityGroup(ctx),
 			"azure_automation_account":                                     tableAzureApAutomationAccount(ctx),
+			"azure_automation_schedule":                                    tableAzureAutomationSchedule(ctx),
 			"azure_automation_variable":                                    tableAzureApAutomationVariable(ctx),
 			"azure_backup_policy":                                          tableAzureBackupPolicy(ctx),
 			"azure_bastion_host":                                           tableAzureBastionHost(ctx),
 			"azure_batch_account":                                          tableAzureBatchAccount(ctx),
+			"azure_batch_pool":                                             tableAzureBatchPool(ctx),
+			"azure_bot_service":                                            tableAzureBotService(ctx),
 			"azure_cdn_frontdoor_profile":                                  tableAzureCDNFrontDoorProfile(ctx),
 			"azure_cognitive_account":                                      tableAzureCognitiveAccount(ctx),
+			"azure_cognitive_account_deployment":                           tableAzureCognitiveAccountDeployment(ctx),
+			"azure_communication_service":                                  tableAzureCommunicationService(ctx),
 			"azure_compute_availability_set":                               tableAzureComputeAvailabilitySet(ctx),
 			"azure_compute_disk":                                           tableAzureComputeDisk(ctx),
 			"azure_compute_disk_access":                                    tableAzureComputeDiskAccess(ctx),
 			"azure_compute_disk_encryption_set":                            tableAzureComputeDiskEncryptionSet(ctx),
+			"azure_compute_disk_metric_read_bytes_daily":                   tableAzureComputeDiskMetricReadBytesDaily(ctx),
+			"azure_compute_disk_metric_read_bytes_hourly":                  tableAzureComputeDiskMetricReadBytesHourly(ctx),
 			"azure_compute_disk_metric_read_ops":                           tableAzureComputeDiskMetricReadOps(ctx),
 			"azure_compute_disk_metric_read_ops_daily":                     tableAzureComputeDiskMetricReadOpsDaily(ctx),
 			"azure_compute_disk_metric_read_ops_hourly":                    tableAzureComputeDiskMetricReadOpsHourly(ctx),
+			"azure_compute_disk_metric_write_bytes_daily":                  tableAzureComputeDiskMetricWriteBytesDaily(ctx),
+			"azure_compute_disk_metric_write_bytes_hourly":                 tableAzureComputeDiskMetricWriteBytesHourly(ctx),
 			"azure_compute_disk_metric_write_ops":                          tableAzureComputeDiskMetricWriteOps(ctx),
 			"azure_compute_disk_metric_write_ops_daily":                    tableAzureComputeDiskMetricWriteOpsDaily(ctx),
 			"azure_compute_disk_metric_write_ops_hourly":                   tableAzureComputeDiskMetricWriteOpsHourly(ctx),
+			"azure_compute_gallery":                                        tableAzureComputeGallery(ctx),
+			"azure_compute_gallery_application":                            tableAzureComputeGalleryApplication(ctx),
+			"azure_compute_gallery_application_version":                    tableAzureComputeGalleryApplicationVersion(ctx),
 			"azure_compute_image":                                          tableAzureComputeImage(ctx),
 			"azure_compute_resource_sku":                                   tableAzureResourceSku(ctx),
 			"azure_compute_snapshot":                                       tableAzureComputeSnapshot(ctx),
@@ -77,16 +103,23 @@ func Plugin(ctx context.Context) *plugin.Plugin {
 			"azure_compute_virtual_machine_metric_cpu_utilization_daily":   tableAzureComputeVirtualMachineMetricCpuUtilizationDaily(ctx),
 			"azure_compute_virtual_machine_metric_cpu_utilization_hourly":  tableAzureComputeVirtualMachineMetricCpuUtilizationHourly(ctx),
 			"azure_compute_virtual_machine_scale_set":                      tableAzureComputeVirtualMachineScaleSet(ctx),
+			"azure_compute_virtual_machine_scale_set_extension":            tableAzureComputeVirtualMachineScaleSetExtension(ctx),
 			"azure_compute_virtual_machine_scale_set_network_interface":    tableAzureComputeVirtualMachineScaleSetNetworkInterface(ctx),
 			"azure_compute_virtual_machine_scale_set_vm":                   tableAzureComputeVirtualMachineScaleSetVm(ctx),
 			"azure_consumption_usage":                                      tableAzureConsumptionUsage(ctx),
 			"azure_container_group":                                        tableAzureContainerGroup(ctx),
 			"azure_container_registry":                                     tableAzureContainerRegistry(ctx),
+			"azure_container_registry_task":                                tableAzureContainerRegistryTask(ctx),
 			"azure_cosmosdb_account":                                       tableAzureCosmosDBAccount(ctx),
+			"azure_cosmosdb_cassandra_keyspace":                            tableAzureCosmosDBCassandraKeyspace(ctx),
+			"azure_cosmosdb_cassandra_table":                               tableAzureCosmosDBCassandraTable(ctx),
+			"azure_cosmosdb_gremlin_database":                              tableAzureCosmosDBGremlinDatabase(ctx),
+			"azure_cosmosdb_gremlin_graph":                                 tableAzureCosmosDBGremlinGraph(ctx),
 			"azure_cosmosdb_mongo_collection":                              tableAzureCosmosDBMongoCollection(ctx),
 			"azure_cosmosdb_mongo_database":                                tableAzureCosmosDBMongoDatabase(ctx),
 			"azure_cosmosdb_restorable_database_account":                   tableAzureCosmosDBRestorableDatabaseAccount(ctx),
 			"azure_cosmosdb_sql_database":                                  tableAzureCosmosDBSQLDatabase(ctx),
+			"azure_cosmosdb_table":                                         tableAzureCosmosDBTable(ctx),
 			"azure_data_factory":                                           tableAzureDataFactory(ctx),
 			"azure_data_factory_dataset":                                   tableAzureDataFactoryDataset(ctx),
 			"azure_data_factory_pipeline":                                  tableAzureDataFactoryPipeline(ctx),
@@ -99,12 +132,19 @@ func Plugin(ctx context.Context) *plugin.Plugin {
 			"azure_diagnostic_setting":                                     tableAzureDiagnosticSetting(ctx),
 			"azure_dns_zone":                                               tableAzureDNSZone(ctx),
 			"azure_eventgrid_domain":                                       tableAzureEventGridDomain(ctx),
+			"azure_eventgrid_system_topic":                                 tableAzureEventGridSystemTopic(ctx),
+			"azure_eventgrid_system_topic_event_subscription":              tableAzureEventGridSystemTopicEventSubscription(ctx),
 			"azure_eventgrid_topic":                                        tableAzureEventGridTopic(ctx),
+			"azure_eventhub":                                               tableAzureEventHub(ctx),
+			"azure_eventhub_metric_incoming_bytes_hourly":                  tableAzureEventHubMetricIncomingBytesHourly(ctx),
+			"azure_eventhub_metric_incoming_messages_hourly":               tableAzureEventHubMetricIncomingMessagesHourly(ctx),
+			"azure_eventhub_metric_outgoing_messages_hourly":               tableAzureEventHubMetricOutgoingMessagesHourly(ctx),
 			"azure_eventhub_namespace":                                     tableAzureEventHubNamespace(ctx),
 			"azure_express_route_circuit":                                  tableAzureExpressRouteCircuit(ctx),
 			"azure_firewall":                                               tableAzureFirewall(ctx),
 			"azure_firewall_policy":                                        tableAzureFirewallPolicy(ctx),
 			"azure_frontdoor":                                              tableAzureFrontDoor(ctx),
+			"azure_grafana_dashboard":                                      tableAzureGrafanaDashboard(ctx),
 			"azure_hdinsight_cluster":                                      tableAzureHDInsightCluster(ctx),
 			"azure_healthcare_service":                                     tableAzureHealthcareService(ctx),
 			"azure_hpc_cache":                                              tableAzureHPCCache(ctx),
@@ -113,16 +153,19 @@ func Plugin(ctx context.Context) *plugin.Plugin {
 			"azure_iothub":                                                 tableAzureIotHub(ctx),
 			"azure_iothub_dps":                                             tableAzureIotHubDps(ctx),
 			"azure_key_vault":                                              tableAzureKeyVault(ctx),
+			"azure_key_vault_certificate_contact":                          tableAzureKeyVaultCertificateContact(ctx),
 			"azure_key_vault_deleted_vault":                                tableAzureKeyVaultDeletedVault(ctx),
 			"azure_key_vault_key":                                          tableAzureKeyVaultKey(ctx),
 			"azure_key_vault_key_version":                                  tableAzureKeyVaultKeyVersion(ctx),
 			"azure_key_vault_managed_hardware_security_module":             tableAzureKeyVaultManagedHardwareSecurityModule(ctx),
 			"azure_key_vault_secret":                                       tableAzureKeyVaultSecret(ctx),
 			"azure_kubernetes_cluster":                                     tableAzureKubernetesCluster(ctx),
+			"azure_kubernetes_cluster_node_pool":                           tableAzureKubernetesClusterNodePool(ctx),
 			"azure_kubernetes_service_version":                             tableAzureAKSVersion(ctx),
 			"azure_kusto_cluster":                                          tableAzureKustoCluster(ctx),
 			"azure_lb":                                                     tableAzureLoadBalancer(ctx),
 			"azure_lb_backend_address_pool":                                tableAzureLoadBalancerBackendAddressPool(ctx),
+			"azure_lb_frontend_ip_configuration":                           tableAzureLoadBalancerFrontendIPConfiguration(ctx),
 			"azure_lb_nat_rule":                                            tableAzureLoadBalancerNatRule(ctx),
 			"azure_lb_outbound_rule":                                       tableAzureLoadBalancerOutboundRule(ctx),
 			"azure_lb_probe":                                               tableAzureLoadBalancerProbe(ctx),
@@ -131,42 +174,66 @@ func Plugin(ctx context.Context) *plugin.Plugin {
 			"azure_lighthouse_definition":                                  tableAzureLighthouseDefinition(ctx),
 			"azure_location":                                               tableAzureLocation(ctx),
 			"azure_log_alert":                                              tableAzureLogAlert(ctx),
+			"azure_log_analytics_linked_service":                           tableAzureLogAnalyticsLinkedService(ctx),
 			"azure_log_analytics_workspace":                                tableAzureLogAnalyticsWorkspace(ctx),
+			"azure_log_analytics_workspace_table":                          tableAzureLogAnalyticsWorkspaceTable(ctx),
 			"azure_log_profile":                                            tableAzureLogProfile(ctx),
 			"azure_logic_app_workflow":                                     tableAzureLogicAppWorkflow(ctx),
 			"azure_machine_learning_workspace":                             tableAzureMachineLearningWorkspace(ctx),
 			"azure_maintenance_configuration":                              tableAzureMaintenanceConfiguration(ctx),
 			"azure_management_group":                                       tableAzureManagementGroup(ctx),
+			"azure_management_group_subscription_association":              tableAzureManagementGroupSubscriptionAssociation(ctx),
 			"azure_management_lock":                                        tableAzureManagementLock(ctx),
+			"azure_maps_account":                                           tableAzureMapsAccount(ctx),
 			"azure_mariadb_server":                                         tableAzureMariaDBServer(ctx),
+			"azure_mariadb_server_configuration":                           tableAzureMariaDBServerConfiguration(ctx),
+			"azure_media_service":                                          tableAzureMediaService(ctx),
 			"azure_monitor_activity_log_event":                             tableAzureMonitorActivityLogEvent(ctx),
+			"azure_monitor_diagnostic_setting":                             tableAzureMonitorDiagnosticSetting(ctx),
 			"azure_monitor_log_profile":                                    tableAzureMonitorLogProfile(ctx),
+			"azure_monitor_scheduled_query_rule":                           tableAzureMonitorScheduledQueryRule(ctx),
 			"azure_mssql_elasticpool":                                      tableAzureMSSQLElasticPool(ctx),
 			"azure_mssql_managed_instance":                                 tableAzureMSSQLManagedInstance(ctx),
 			"azure_mssql_virtual_machine":                                  tableAzureMSSQLVirtualMachine(ctx),
 			"azure_mysql_flexible_server":                                  tableAzureMySQLFlexibleServer(ctx),
+			"azure_mysql_flexible_server_backup":                           tableAzureMySQLFlexibleServerBackup(ctx),
 			"azure_mysql_server":                                           tableAzureMySQLServer(ctx),
+			"azure_mysql_server_key":                                       tableAzureMySQLServerKey(ctx),
 			"azure_nat_gateway":                                            tableAzureNatGateway(ctx),
+			"azure_network_connection_monitor":                             tableAzureNetworkConnectionMonitor(ctx),
 			"azure_network_interface":                                      tableAzureNetworkInterface(ctx),
 			"azure_network_security_group":                                 tableAzureNetworkSecurityGroup(ctx),
+			"azure_network_security_rule":                                  tableAzureNetworkSecurityRule(ctx),
 			"azure_network_watcher":                                        tableAzureNetworkWatcher(ctx),
 			"azure_network_watcher_flow_log":                               tableAzureNetworkWatcherFlowLog(ctx),
+			"azure_pim_role_assignment":                                    tableAzurePimRoleAssignment(ctx),
 			"azure_policy_assignment":                                      tableAzurePolicyAssignment(ctx),
 			"azure_policy_definition":                                      tableAzurePolicyDefinition(ctx),
 			"azure_postgresql_flexible_server":                             tableAzurePostgreSqlFlexibleServer(ctx),
+			"azure_postgresql_flexible_server_firewall_rule":               tableAzurePostgreSqlFlexibleServerFirewallRule(ctx),
 			"azure_postgresql_server":                                      tableAzurePostgreSqlServer(ctx),
+			"azure_postgresql_server_configuration":                        tableAzurePostgreSQLServerConfiguration(ctx),
 			"azure_private_dns_zone":                                       tableAzurePrivateDNSZone(ctx),
 			"azure_private_endpoint":                                       tableAzurePrivateEndpoint(ctx),
+			"azure_prometheus_workspace":                                   tableAzurePrometheusWorkspace(ctx),
 			"azure_provider":                                               tableAzureProvider(ctx),
 			"azure_public_ip":                                              tableAzurePublicIP(ctx),
 			"azure_recovery_services_backup_job":                           tableAzureRecoveryServicesBackupJob(ctx),
 			"azure_recovery_services_vault":                                tableAzureRecoveryServicesVault(ctx),
+			"azure_red_hat_openshift_cluster":                              tableAzureRedHatOpenShiftCluster(ctx),
 			"azure_redis_cache":                                            tableAzureRedisCache(ctx),
+			"azure_redis_cache_metric_evicted_keys_hourly":                 tableAzureRedisCacheMetricEvictedKeysHourly(ctx),
+			"azure_redis_cache_metric_server_load_daily":                   tableAzureRedisCacheMetricServerLoadDaily(ctx),
+			"azure_redis_cache_metric_server_load_hourly":                  tableAzureRedisCacheMetricServerLoadHourly(ctx),
+			"azure_redis_enterprise_cluster":                               tableAzureRedisEnterpriseCluster(ctx),
+			"azure_redis_enterprise_database":                              tableAzureRedisEnterpriseDatabase(ctx),
+			"azure_resource_change":                                        tableAzureResourceChange(ctx),
 			"azure_resource_group":                                         tableAzureResourceGroup(ctx),
 			"azure_resource_link":                                          tableAzureResourceLink(ctx),
 			"azure_role_assignment":                                        tableAzureIamRoleAssignment(ctx),
 			"azure_role_definition":                                        tableAzureIamRoleDefinition(ctx),
 			"azure_route_table":                                            tableAzureRouteTable(ctx),
+			"azure_route_table_route":                                      tableAzureRouteTableRoute(ctx),
 			"azure_search_service":                                         tableAzureSearchService(ctx),
 			"azure_security_center_auto_provisioning":                      tableAzureSecurityCenterAutoProvisioning(ctx),
 			"azure_security_center_automation":                             tableAzureSecurityCenterAutomation(ctx),
@@ -175,13 +242,23 @@ func Plugin(ctx context.Context) *plugin.Plugin {
 			"azure_security_center_setting":                                tableAzureSecurityCenterSetting(ctx),
 			"azure_security_center_sub_assessment":                         tableAzureSecurityCenterSubAssessment(ctx),
 			"azure_security_center_subscription_pricing":                   tableAzureSecurityCenterPricing(ctx),
+			"azure_sentinel_incident":                                      tableAzureSentinelIncident(ctx),
 			"azure_service_fabric_cluster":                                 tableAzureServiceFabricCluster(ctx),
+			"azure_service_fabric_managed_cluster":                         tableAzureServiceFabricManagedCluster(ctx),
 			"azure_servicebus_namespace":                                   tableAzureServiceBusNamespace(ctx),
 			"azure_signalr_service":                                        tableAzureSignalRService(ctx),
+			"azure_sphere_catalog":                                         tableAzureSphereCatalog(ctx),
+			"azure_sphere_device_group":                                    tableAzureSphereDeviceGroup(ctx),
+			"azure_sphere_product":                                         tableAzureSphereProduct(ctx),
 			"azure_spring_cloud_service":                                   tableAzureSpringCloudService(ctx),
 			"azure_sql_database":                                           tableAzureSqlDatabase(ctx),
+			"azure_sql_database_geo_backup_policy":                         tableAzureSQLDatabaseGeoBackupPolicy(ctx),
+			"azure_sql_database_long_term_retention_backup":                tableAzureSQLDatabaseLongTermRetentionBackup(ctx),
+			"azure_sql_managed_instance_encryption_protector":              tableAzureSQLManagedInstanceEncryptionProtector(ctx),
 			"azure_sql_server":                                             tableAzureSQLServer(ctx),
+			"azure_sql_server_extended_auditing_policy":                    tableAzureSQLServerExtendedAuditingPolicy(ctx),
 			"azure_storage_account":                                        tableAzureStorageAccount(ctx),
+			"azure_storage_account_cors_rule":                              tableAzureStorageAccountCorsRule(ctx),
 			"azure_storage_blob":                                           tableAzureStorageBlob(ctx),
 			"azure_storage_blob_service":                                   tableAzureStorageBlobService(ctx),
 			"azure_storage_container":                                      tableAzureStorageContainer(ctx),
@@ -193,10 +270,19 @@ func Plugin(ctx context.Context) *plugin.Plugin {
 			"azure_stream_analytics_job":                                   tableAzureStreamAnalyticsJob(ctx),
 			"azure_subnet":                                                 tableAzureSubnet(ctx),
 			"azure_subscription":                                           tableAzureSubscription(ctx),
+			"azure_subscription_quota":                                     tableAzureSubscriptionQuota(ctx),
+			"azure_synapse_spark_pool":                                     tableAzureSynapseSparkPool(ctx),
+			"azure_synapse_sql_pool":                                       tableAzureSynapseSQLPool(ctx),
 			"azure_synapse_workspace":                                      tableAzureSynapseWorkspace(ctx),
 			"azure_tenant":                                                 tableAzureTenant(ctx),
+			"azure_user_assigned_identity":                                 tableAzureUserAssignedIdentity(ctx),
+			"azure_user_assigned_identity_federated_credential":            tableAzureUserAssignedIdentityFederatedCredential(ctx),
 			"azure_virtual_network":                                        tableAzureVirtualNetwork(ctx),
 			"azure_virtual_network_gateway":                                tableAzureVirtualNetworkGateway(ctx),
+			"azure_virtual_network_peering":                                tableAzureVirtualNetworkPeering(ctx),
+			"azure_virtual_network_tap":                                    tableAzureVirtualNetworkTap(ctx),
+			"azure_web_app_connection_string":                              tableAzureWebAppConnectionString(ctx),
+			"azure_web_pubsub":                                             tableAzureWebPubSub(ctx),
 		},
 	}
 
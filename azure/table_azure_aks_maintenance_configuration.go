@@ -0,0 +1,204 @@
+package azure
+
+import (
+	"context"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/profiles/latest/containerservice/mgmt/containerservice"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+)
+
+//// TABLE DEFINITION
+
+func tableAzureAKSMaintenanceConfiguration(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "azure_aks_maintenance_configuration",
+		Description: "Azure AKS Maintenance Configuration",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.AllColumns([]string{"cluster_name", "name", "resource_group"}),
+			Hydrate:    getAKSMaintenanceConfiguration,
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: isNotFoundError([]string{"ResourceNotFound", "ResourceGroupNotFound", "NotFound", "404"}),
+			},
+		},
+		List: &plugin.ListConfig{
+			Hydrate:       listAKSMaintenanceConfigurations,
+			ParentHydrate: listKubernetesClusters,
+		},
+		Columns: azureColumns([]*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name of the maintenance configuration.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "id",
+				Description: "The ID of the maintenance configuration.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromGo(),
+			},
+			{
+				Name:        "cluster_name",
+				Description: "The name of the managed cluster the maintenance configuration belongs to.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "type",
+				Description: "The type of the resource.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "time_in_week",
+				Description: "The days of the week and the hour slots in which the maintenance is allowed. If two entries specify the same day of the week, the applied configuration is the union of times in both entries.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("MaintenanceConfigurationProperties.TimeInWeek"),
+			},
+			{
+				Name:        "not_allowed_time",
+				Description: "Time slots on which upgrade is not allowed.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("MaintenanceConfigurationProperties.NotAllowedTime"),
+			},
+			{
+				Name:        "system_data",
+				Description: "The system metadata relating to this resource.",
+				Type:        proto.ColumnType_JSON,
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+			{
+				Name:        "akas",
+				Description: ColumnDescriptionAkas,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("ID").Transform(idToAkas),
+			},
+
+			// Azure standard columns
+			{
+				Name:        "region",
+				Description: ColumnDescriptionRegion,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Location").Transform(toLower),
+			},
+			{
+				Name:        "resource_group",
+				Description: ColumnDescriptionResourceGroup,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ID").Transform(extractResourceGroupFromID),
+			},
+		}),
+	}
+}
+
+type AKSMaintenanceConfigurationInfo = struct {
+	containerservice.MaintenanceConfiguration
+	ClusterName *string
+	Location    *string
+}
+
+//// LIST FUNCTION
+
+func listAKSMaintenanceConfigurations(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	// Get the details of the managed cluster
+	cluster := h.Item.(containerservice.ManagedCluster)
+	resourceGroup := strings.Split(*cluster.ID, "/")[4]
+
+	session, err := GetNewSession(ctx, d, "MANAGEMENT")
+	if err != nil {
+		return nil, err
+	}
+	subscriptionID := session.SubscriptionID
+
+	client := containerservice.NewMaintenanceConfigurationsClientWithBaseURI(session.ResourceManagerEndpoint, subscriptionID)
+	client.Authorizer = session.Authorizer
+
+	result, err := client.ListByManagedCluster(ctx, resourceGroup, *cluster.Name)
+	if err != nil {
+		plugin.Logger(ctx).Error("listAKSMaintenanceConfigurations", "list", err)
+		return nil, err
+	}
+
+	for _, config := range result.Values() {
+		d.StreamListItem(ctx, AKSMaintenanceConfigurationInfo{config, cluster.Name, cluster.Location})
+		// Check if context has been cancelled or if the limit has been hit (if specified)
+		// if there is a limit, it will return the number of rows required to reach this limit
+		if d.RowsRemaining(ctx) == 0 {
+			return nil, nil
+		}
+	}
+
+	for result.NotDone() {
+		err = result.NextWithContext(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("listAKSMaintenanceConfigurations", "list_paging", err)
+			return nil, err
+		}
+		for _, config := range result.Values() {
+			d.StreamListItem(ctx, AKSMaintenanceConfigurationInfo{config, cluster.Name, cluster.Location})
+			// Check if context has been cancelled or if the limit has been hit (if specified)
+			// if there is a limit, it will return the number of rows required to reach this limit
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, err
+}
+
+//// HYDRATE FUNCTIONS
+
+func getAKSMaintenanceConfiguration(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("getAKSMaintenanceConfiguration")
+
+	clusterName := d.EqualsQuals["cluster_name"].GetStringValue()
+	name := d.EqualsQuals["name"].GetStringValue()
+	resourceGroup := d.EqualsQuals["resource_group"].GetStringValue()
+
+	// Handle empty check
+	if clusterName == "" || name == "" || resourceGroup == "" {
+		return nil, nil
+	}
+
+	session, err := GetNewSession(ctx, d, "MANAGEMENT")
+	if err != nil {
+		return nil, err
+	}
+	subscriptionID := session.SubscriptionID
+
+	client := containerservice.NewMaintenanceConfigurationsClientWithBaseURI(session.ResourceManagerEndpoint, subscriptionID)
+	client.Authorizer = session.Authorizer
+
+	op, err := client.Get(ctx, resourceGroup, clusterName, name)
+	if err != nil {
+		plugin.Logger(ctx).Error("getAKSMaintenanceConfiguration", "get", err)
+		return nil, err
+	}
+
+	// In some cases resource does not give any notFound error
+	// instead of notFound error, it returns empty data
+	if op.ID == nil {
+		return nil, nil
+	}
+
+	// The maintenance configuration does not return the location, so it is taken from the cluster
+	clusterClient := containerservice.NewManagedClustersClientWithBaseURI(session.ResourceManagerEndpoint, subscriptionID)
+	clusterClient.Authorizer = session.Authorizer
+
+	cluster, err := clusterClient.Get(ctx, resourceGroup, clusterName)
+	if err != nil {
+		plugin.Logger(ctx).Error("getAKSMaintenanceConfiguration", "get_cluster", err)
+		return nil, err
+	}
+
+	return AKSMaintenanceConfigurationInfo{op, cluster.Name, cluster.Location}, nil
+}
@@ -27,6 +27,13 @@ func tableAzureAPIManagement(_ context.Context) *plugin.Table {
 		List: &plugin.ListConfig{
 			Hydrate: listAPIManagements,
 		},
+		HydrateConfig: []plugin.HydrateConfig{
+			{
+				Func:           listAPIManagementDiagnosticSettings,
+				MaxConcurrency: diagnosticSettingsMaxConcurrency,
+				Tags:           map[string]string{"service": "Microsoft.Insights", "action": "diagnosticSettings/list"},
+			},
+		},
 		Columns: azureColumns([]*plugin.Column{
 			{
 				Name:        "name",
@@ -123,6 +130,12 @@ func tableAzureAPIManagement(_ context.Context) *plugin.Table {
 				Type:        proto.ColumnType_STRING,
 				Transform:   transform.FromField("ServiceProperties.NotificationSenderEmail"),
 			},
+			{
+				Name:        "platform_version",
+				Description: "The compute platform version running the service. Possible values include: 'undetermined', 'stv1', 'stv2', 'mtv1'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ServiceProperties.PlatformVersion"),
+			},
 			{
 				Name:        "portal_url",
 				Description: "Publisher portal endpoint URL of the API management service.",
@@ -141,6 +154,12 @@ func tableAzureAPIManagement(_ context.Context) *plugin.Table {
 				Type:        proto.ColumnType_STRING,
 				Transform:   transform.FromField("ServiceProperties.PublisherName"),
 			},
+			{
+				Name:        "public_network_access",
+				Description: "Whether or not public endpoint access is allowed for the service. Possible values include: 'Enabled', 'Disabled'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ServiceProperties.PublicNetworkAccess"),
+			},
 			{
 				Name:        "restore",
 				Description: "Undelete API management service if it was previously soft-deleted.",
@@ -251,6 +270,12 @@ func tableAzureAPIManagement(_ context.Context) *plugin.Table {
 				Type:        proto.ColumnType_JSON,
 				Transform:   transform.FromField("ServiceProperties.PublicIPAddresses"),
 			},
+			{
+				Name:        "virtual_network_configuration",
+				Description: "The virtual network configuration of the service, with the virtual network ID and the subnet the service is deployed in.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.From(extractAPIManagementVirtualNetworkConfiguration),
+			},
 			{
 				Name:        "zones",
 				Description: "A list of availability zones denoting where the resource needs to come from.",
@@ -413,3 +438,25 @@ func listAPIManagementDiagnosticSettings(ctx context.Context, d *plugin.QueryDat
 	}
 	return diagnosticSettings, nil
 }
+
+//// TRANSFORM FUNCTIONS
+
+// If we return the API response directly, the output will not provide the read-only properties of VirtualNetworkConfiguration
+func extractAPIManagementVirtualNetworkConfiguration(ctx context.Context, d *transform.TransformData) (interface{}, error) {
+	service := d.HydrateItem.(apimanagement.ServiceResource)
+	if service.ServiceProperties == nil || service.VirtualNetworkConfiguration == nil {
+		return nil, nil
+	}
+
+	configuration := make(map[string]interface{})
+	if service.VirtualNetworkConfiguration.Vnetid != nil {
+		configuration["vnetid"] = *service.VirtualNetworkConfiguration.Vnetid
+	}
+	if service.VirtualNetworkConfiguration.Subnetname != nil {
+		configuration["subnetname"] = *service.VirtualNetworkConfiguration.Subnetname
+	}
+	if service.VirtualNetworkConfiguration.SubnetResourceID != nil {
+		configuration["subnetResourceId"] = *service.VirtualNetworkConfiguration.SubnetResourceID
+	}
+	return configuration, nil
+}
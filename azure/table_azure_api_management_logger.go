@@ -0,0 +1,235 @@
+package azure
+
+import (
+	"context"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/profiles/latest/apimanagement/mgmt/apimanagement"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+)
+
+//// TABLE DEFINITION ////
+
+func tableAzureAPIManagementLogger(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "azure_api_management_logger",
+		Description: "Azure API Management Logger",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.AllColumns([]string{"name", "resource_group", "service_name"}),
+			Hydrate:    getAPIManagementLogger,
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: isNotFoundError([]string{"ResourceNotFound", "ResourceGroupNotFound"}),
+			},
+		},
+		List: &plugin.ListConfig{
+			ParentHydrate: listAPIManagements,
+			Hydrate:       listAPIManagementLoggers,
+			KeyColumns: plugin.KeyColumnSlice{
+				{
+					Name:    "service_name",
+					Require: plugin.Optional,
+				},
+				{
+					Name:    "resource_group",
+					Require: plugin.Optional,
+				},
+			},
+		},
+		Columns: azureColumns([]*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The identifier of the API management logger.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "id",
+				Description: "Contains ID to identify an API management logger uniquely.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromGo(),
+			},
+			{
+				Name:        "type",
+				Description: "Resource type for API Management resource.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "logger_type",
+				Description: "The logger type. Possible values include: 'azureEventHub', 'applicationInsights', 'azureMonitor'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("LoggerContractProperties.LoggerType"),
+			},
+			{
+				Name:        "description",
+				Description: "The logger description.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("LoggerContractProperties.Description"),
+			},
+			{
+				Name:        "is_buffered",
+				Description: "Whether records are buffered in the logger before publishing.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("LoggerContractProperties.IsBuffered"),
+			},
+			{
+				Name:        "resource_id",
+				Description: "The ID of the log target, either an Azure Event Hub or an Azure Application Insights resource.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("LoggerContractProperties.ResourceID"),
+			},
+			{
+				Name:        "credentials",
+				Description: "The names of the credentials of the logger. Their values are masked.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("LoggerContractProperties.Credentials").Transform(maskAPIManagementLoggerCredentials),
+			},
+			{
+				Name:        "service_name",
+				Description: "Name of the API management service.",
+				Type:        proto.ColumnType_STRING,
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+			{
+				Name:        "akas",
+				Description: ColumnDescriptionAkas,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("ID").Transform(idToAkas),
+			},
+
+			// Azure standard columns
+			{
+				Name:        "resource_group",
+				Description: ColumnDescriptionResourceGroup,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ID").Transform(extractResourceGroupFromID),
+			},
+		}),
+	}
+}
+
+type LoggerWithServiceName struct {
+	apimanagement.LoggerContract
+	ServiceName string
+}
+
+//// LIST FUNCTION
+
+func listAPIManagementLoggers(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	serviceInfo := h.Item.(apimanagement.ServiceResource)
+	serviceName := *serviceInfo.Name
+	resourceGroup := strings.Split(*serviceInfo.ID, "/")[4]
+
+	if d.EqualsQualString("service_name") != "" && d.EqualsQualString("service_name") != serviceName {
+		return nil, nil
+	}
+	if d.EqualsQualString("resource_group") != "" && !strings.EqualFold(d.EqualsQualString("resource_group"), resourceGroup) {
+		return nil, nil
+	}
+
+	session, err := GetNewSession(ctx, d, "MANAGEMENT")
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_api_management_logger.listAPIManagementLoggers", "session_error", err)
+		return nil, err
+	}
+	subscriptionID := session.SubscriptionID
+
+	apiManagementLoggerClient := apimanagement.NewLoggerClientWithBaseURI(session.ResourceManagerEndpoint, subscriptionID)
+	apiManagementLoggerClient.Authorizer = session.Authorizer
+
+	result, err := apiManagementLoggerClient.ListByService(ctx, resourceGroup, serviceName, "", nil, nil)
+	if err != nil {
+		// API throws error during the resource creation with status code 400.
+		// azure: Status=400 Code="InvalidOperation" Message="API Management service is activating"
+		if strings.Contains(err.Error(), "API Management service is activating") {
+			return nil, nil
+		}
+		plugin.Logger(ctx).Error("azure_api_management_logger.listAPIManagementLoggers", "api_error", err)
+		return nil, err
+	}
+
+	for _, apiManagementLogger := range result.Values() {
+		d.StreamListItem(ctx, &LoggerWithServiceName{apiManagementLogger, serviceName})
+		// Check if context has been cancelled or if the limit has been hit (if specified)
+		// if there is a limit, it will return the number of rows required to reach this limit
+		if d.RowsRemaining(ctx) == 0 {
+			return nil, nil
+		}
+	}
+
+	for result.NotDone() {
+		err = result.NextWithContext(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("azure_api_management_logger.listAPIManagementLoggers", "list_paging", err)
+			return nil, err
+		}
+
+		for _, apiManagementLogger := range result.Values() {
+			d.StreamListItem(ctx, &LoggerWithServiceName{apiManagementLogger, serviceName})
+			// Check if context has been cancelled or if the limit has been hit (if specified)
+			// if there is a limit, it will return the number of rows required to reach this limit
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getAPIManagementLogger(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	name := d.EqualsQualString("name")
+	serviceName := d.EqualsQualString("service_name")
+	resourceGroup := d.EqualsQualString("resource_group")
+
+	// Handle empty name, serviceName or resourceGroup
+	if name == "" || serviceName == "" || resourceGroup == "" {
+		return nil, nil
+	}
+
+	session, err := GetNewSession(ctx, d, "MANAGEMENT")
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_api_management_logger.getAPIManagementLogger", "session_error", err)
+		return nil, err
+	}
+	subscriptionID := session.SubscriptionID
+
+	apiManagementLoggerClient := apimanagement.NewLoggerClientWithBaseURI(session.ResourceManagerEndpoint, subscriptionID)
+	apiManagementLoggerClient.Authorizer = session.Authorizer
+
+	op, err := apiManagementLoggerClient.Get(ctx, resourceGroup, serviceName, name)
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_api_management_logger.getAPIManagementLogger", "api_error", err)
+		return nil, err
+	}
+
+	return &LoggerWithServiceName{op, serviceName}, nil
+}
+
+//// TRANSFORM FUNCTIONS
+
+// The credentials hold the instrumentation key or the Event Hub connection
+// string, so only their names are exposed
+func maskAPIManagementLoggerCredentials(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	credentials, ok := d.Value.(map[string]*string)
+	if !ok || credentials == nil {
+		return nil, nil
+	}
+
+	masked := make(map[string]string, len(credentials))
+	for name := range credentials {
+		masked[name] = "********"
+	}
+
+	return masked, nil
+}
@@ -0,0 +1,299 @@
+package azure
+
+import (
+	"context"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/profiles/latest/apimanagement/mgmt/apimanagement"
+	"github.com/turbot/go-kit/types"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+)
+
+//// TABLE DEFINITION ////
+
+func tableAzureAPIManagementSubscription(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "azure_api_management_subscription",
+		Description: "Azure API Management Subscription",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.AllColumns([]string{"name", "resource_group", "service_name"}),
+			Hydrate:    getAPIManagementSubscription,
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: isNotFoundError([]string{"ResourceNotFound", "ResourceGroupNotFound"}),
+			},
+		},
+		List: &plugin.ListConfig{
+			ParentHydrate: listAPIManagements,
+			Hydrate:       listAPIManagementSubscriptions,
+			KeyColumns: plugin.KeyColumnSlice{
+				{
+					Name:    "service_name",
+					Require: plugin.Optional,
+				},
+				{
+					Name:    "resource_group",
+					Require: plugin.Optional,
+				},
+			},
+		},
+		Columns: azureColumns([]*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The identifier of the API management subscription.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "id",
+				Description: "Contains ID to identify an API management subscription uniquely.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromGo(),
+			},
+			{
+				Name:        "type",
+				Description: "Resource type for API Management resource.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "display_name",
+				Description: "The name of the subscription.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("SubscriptionContractProperties.DisplayName"),
+			},
+			{
+				Name:        "state",
+				Description: "The state of the subscription. Possible values include: 'suspended', 'active', 'expired', 'submitted', 'rejected', 'cancelled'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("SubscriptionContractProperties.State"),
+			},
+			{
+				Name:        "state_comment",
+				Description: "Optional subscription comment added by an administrator when the state is changed to 'rejected'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("SubscriptionContractProperties.StateComment"),
+			},
+			{
+				Name:        "created_date",
+				Description: "The date the subscription was created.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("SubscriptionContractProperties.CreatedDate").Transform(convertDateToTime),
+			},
+			{
+				Name:        "start_date",
+				Description: "The date the subscription was activated.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("SubscriptionContractProperties.StartDate").Transform(convertDateToTime),
+			},
+			{
+				Name:        "expiration_date",
+				Description: "The date the subscription will expire.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("SubscriptionContractProperties.ExpirationDate").Transform(convertDateToTime),
+			},
+			{
+				Name:        "end_date",
+				Description: "The date the subscription was cancelled or expired.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("SubscriptionContractProperties.EndDate").Transform(convertDateToTime),
+			},
+			{
+				Name:        "notification_date",
+				Description: "The upcoming subscription expiration notification date.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("SubscriptionContractProperties.NotificationDate").Transform(convertDateToTime),
+			},
+			{
+				Name:        "primary_key",
+				Description: "True if the subscription has a primary key. The key itself is never returned.",
+				Type:        proto.ColumnType_BOOL,
+				Hydrate:     listAPIManagementSubscriptionSecrets,
+				Transform:   transform.FromField("PrimaryKey").Transform(isAPIManagementSubscriptionKeySet),
+			},
+			{
+				Name:        "secondary_key",
+				Description: "True if the subscription has a secondary key. The key itself is never returned.",
+				Type:        proto.ColumnType_BOOL,
+				Hydrate:     listAPIManagementSubscriptionSecrets,
+				Transform:   transform.FromField("SecondaryKey").Transform(isAPIManagementSubscriptionKeySet),
+			},
+			{
+				Name:        "allow_tracing",
+				Description: "Determines whether tracing is enabled.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("SubscriptionContractProperties.AllowTracing"),
+			},
+			{
+				Name:        "owner_id",
+				Description: "The user resource identifier of the subscription owner.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("SubscriptionContractProperties.OwnerID"),
+			},
+			{
+				Name:        "scope",
+				Description: "Scope like /products/{productId} or /apis or /apis/{apiId}.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("SubscriptionContractProperties.Scope"),
+			},
+			{
+				Name:        "service_name",
+				Description: "Name of the API management service.",
+				Type:        proto.ColumnType_STRING,
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("SubscriptionContractProperties.DisplayName", "Name"),
+			},
+			{
+				Name:        "akas",
+				Description: ColumnDescriptionAkas,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("ID").Transform(idToAkas),
+			},
+
+			// Azure standard columns
+			{
+				Name:        "resource_group",
+				Description: ColumnDescriptionResourceGroup,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ID").Transform(extractResourceGroupFromID),
+			},
+		}),
+	}
+}
+
+type SubscriptionWithServiceName struct {
+	apimanagement.SubscriptionContract
+	ServiceName string
+}
+
+//// LIST FUNCTION
+
+func listAPIManagementSubscriptions(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	serviceInfo := h.Item.(apimanagement.ServiceResource)
+	serviceName := *serviceInfo.Name
+	resourceGroup := strings.Split(*serviceInfo.ID, "/")[4]
+
+	if d.EqualsQualString("service_name") != "" && d.EqualsQualString("service_name") != serviceName {
+		return nil, nil
+	}
+	if d.EqualsQualString("resource_group") != "" && !strings.EqualFold(d.EqualsQualString("resource_group"), resourceGroup) {
+		return nil, nil
+	}
+
+	session, err := GetNewSession(ctx, d, "MANAGEMENT")
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_api_management_subscription.listAPIManagementSubscriptions", "session_error", err)
+		return nil, err
+	}
+	subscriptionID := session.SubscriptionID
+
+	apiManagementSubscriptionClient := apimanagement.NewSubscriptionClientWithBaseURI(session.ResourceManagerEndpoint, subscriptionID)
+	apiManagementSubscriptionClient.Authorizer = session.Authorizer
+
+	result, err := apiManagementSubscriptionClient.List(ctx, resourceGroup, serviceName, "", nil, nil)
+	if err != nil {
+		// API throws error during the resource creation with status code 400.
+		// azure: Status=400 Code="InvalidOperation" Message="API Management service is activating"
+		if strings.Contains(err.Error(), "API Management service is activating") {
+			return nil, nil
+		}
+		plugin.Logger(ctx).Error("azure_api_management_subscription.listAPIManagementSubscriptions", "api_error", err)
+		return nil, err
+	}
+
+	for _, subscription := range result.Values() {
+		d.StreamListItem(ctx, &SubscriptionWithServiceName{subscription, serviceName})
+		// Check if context has been cancelled or if the limit has been hit (if specified)
+		// if there is a limit, it will return the number of rows required to reach this limit
+		if d.RowsRemaining(ctx) == 0 {
+			return nil, nil
+		}
+	}
+
+	for result.NotDone() {
+		err = result.NextWithContext(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("azure_api_management_subscription.listAPIManagementSubscriptions", "list_paging", err)
+			return nil, err
+		}
+
+		for _, subscription := range result.Values() {
+			d.StreamListItem(ctx, &SubscriptionWithServiceName{subscription, serviceName})
+			// Check if context has been cancelled or if the limit has been hit (if specified)
+			// if there is a limit, it will return the number of rows required to reach this limit
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getAPIManagementSubscription(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	name := d.EqualsQualString("name")
+	serviceName := d.EqualsQualString("service_name")
+	resourceGroup := d.EqualsQualString("resource_group")
+
+	// Handle empty name, serviceName or resourceGroup
+	if name == "" || serviceName == "" || resourceGroup == "" {
+		return nil, nil
+	}
+
+	session, err := GetNewSession(ctx, d, "MANAGEMENT")
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_api_management_subscription.getAPIManagementSubscription", "session_error", err)
+		return nil, err
+	}
+	subscriptionID := session.SubscriptionID
+
+	apiManagementSubscriptionClient := apimanagement.NewSubscriptionClientWithBaseURI(session.ResourceManagerEndpoint, subscriptionID)
+	apiManagementSubscriptionClient.Authorizer = session.Authorizer
+
+	op, err := apiManagementSubscriptionClient.Get(ctx, resourceGroup, serviceName, name)
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_api_management_subscription.getAPIManagementSubscription", "api_error", err)
+		return nil, err
+	}
+
+	return &SubscriptionWithServiceName{op, serviceName}, nil
+}
+
+// The keys are not returned by the list and get calls, so they are fetched
+// separately to tell whether they are set. Their values are never exposed.
+func listAPIManagementSubscriptionSecrets(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	data := h.Item.(*SubscriptionWithServiceName)
+	resourceGroup := strings.Split(*data.ID, "/")[4]
+
+	session, err := GetNewSession(ctx, d, "MANAGEMENT")
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_api_management_subscription.listAPIManagementSubscriptionSecrets", "session_error", err)
+		return nil, err
+	}
+	subscriptionID := session.SubscriptionID
+
+	apiManagementSubscriptionClient := apimanagement.NewSubscriptionClientWithBaseURI(session.ResourceManagerEndpoint, subscriptionID)
+	apiManagementSubscriptionClient.Authorizer = session.Authorizer
+
+	op, err := apiManagementSubscriptionClient.ListSecrets(ctx, resourceGroup, data.ServiceName, *data.Name)
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_api_management_subscription.listAPIManagementSubscriptionSecrets", "api_error", err)
+		return nil, err
+	}
+
+	return op, nil
+}
+
+//// TRANSFORM FUNCTIONS
+
+func isAPIManagementSubscriptionKeySet(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	return types.SafeString(d.Value) != "", nil
+}
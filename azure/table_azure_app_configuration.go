@@ -3,8 +3,8 @@ package azure
 import (
 	"context"
 
+	"github.com/Azure/azure-sdk-for-go/profiles/preview/preview/appconfiguration/mgmt/appconfiguration"
 	"github.com/Azure/azure-sdk-for-go/profiles/preview/preview/monitor/mgmt/insights"
-	"github.com/Azure/azure-sdk-for-go/profiles/latest/appconfiguration/mgmt/appconfiguration"
 	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
 	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
 
@@ -27,6 +27,13 @@ func tableAzureAppConfiguration(_ context.Context) *plugin.Table {
 		List: &plugin.ListConfig{
 			Hydrate: listAppConfigurations,
 		},
+		HydrateConfig: []plugin.HydrateConfig{
+			{
+				Func:           listAppConfigurationDiagnosticSettings,
+				MaxConcurrency: diagnosticSettingsMaxConcurrency,
+				Tags:           map[string]string{"service": "Microsoft.Insights", "action": "diagnosticSettings/list"},
+			},
+		},
 		Columns: azureColumns([]*plugin.Column{
 			{
 				Name:        "name",
@@ -75,6 +82,12 @@ func tableAzureAppConfiguration(_ context.Context) *plugin.Table {
 				Type:        proto.ColumnType_STRING,
 				Transform:   transform.FromField("Sku.Name"),
 			},
+			{
+				Name:        "soft_delete_retention_days",
+				Description: "The amount of time in days that the configuration store will be retained when it is soft deleted.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("ConfigurationStoreProperties.SoftDeleteRetentionInDays"),
+			},
 			{
 				Name:        "diagnostic_settings",
 				Description: "A list of active diagnostic settings for the configuration store.",
@@ -88,6 +101,18 @@ func tableAzureAppConfiguration(_ context.Context) *plugin.Table {
 				Type:        proto.ColumnType_JSON,
 				Transform:   transform.FromField("ConfigurationStoreProperties.Encryption"),
 			},
+			{
+				Name:        "cmk_enabled",
+				Description: "Indicates whether the configuration store is encrypted with a customer-managed key.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromP(extractAppConfigurationCmkStatus, "Enabled"),
+			},
+			{
+				Name:        "cmk_key_identifier",
+				Description: "The URI of the key vault key used to encrypt the configuration store, if it is encrypted with a customer-managed key.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromP(extractAppConfigurationCmkStatus, "KeyIdentifier"),
+			},
 			{
 				Name:        "identity",
 				Description: "The managed identity information, if configured.",
@@ -269,6 +294,22 @@ func getPublicNetworkAccess(ctx context.Context, d *plugin.QueryData, h *plugin.
 
 //// TRANSFORM FUNCTION
 
+// extractAppConfigurationCmkStatus returns whether the configuration store is encrypted with a customer-managed key, or the URI of that key
+func extractAppConfigurationCmkStatus(ctx context.Context, d *transform.TransformData) (interface{}, error) {
+	configurationStore := d.HydrateItem.(appconfiguration.ConfigurationStore)
+	param := d.Param.(string)
+
+	var keyIdentifier *string
+	if configurationStore.ConfigurationStoreProperties != nil && configurationStore.Encryption != nil && configurationStore.Encryption.KeyVaultProperties != nil {
+		keyIdentifier = configurationStore.Encryption.KeyVaultProperties.KeyIdentifier
+	}
+
+	if param == "Enabled" {
+		return keyIdentifier != nil && *keyIdentifier != "", nil
+	}
+	return keyIdentifier, nil
+}
+
 // If we return the API response directly, the output will not provide all the properties of PrivateEndpointConnections
 func extractAppConfigurationPrivateEndpointConnections(ctx context.Context, d *transform.TransformData) (interface{}, error) {
 	server := d.HydrateItem.(appconfiguration.ConfigurationStore)
@@ -0,0 +1,294 @@
+package azure
+
+import (
+	"context"
+
+	"github.com/Azure/azure-sdk-for-go/profiles/latest/web/mgmt/web"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+)
+
+//// TABLE DEFINITION
+
+func tableAzureAppServiceCertificate(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "azure_app_service_certificate",
+		Description: "Azure App Service Certificate",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.AllColumns([]string{"name", "resource_group"}),
+			Hydrate:    getAppServiceCertificate,
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: isNotFoundError([]string{"ResourceNotFound", "ResourceGroupNotFound", "404"}),
+			},
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listAppServiceCertificates,
+		},
+		Columns: azureColumns([]*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name of the certificate.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "id",
+				Description: "The resource ID of the certificate.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromGo(),
+			},
+			{
+				Name:        "type",
+				Description: "The type of the resource.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "kind",
+				Description: "The kind of the resource.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "friendly_name",
+				Description: "The friendly name of the certificate.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("CertificateProperties.FriendlyName"),
+			},
+			{
+				Name:        "subject_name",
+				Description: "The subject name of the certificate.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("CertificateProperties.SubjectName"),
+			},
+			{
+				Name:        "issuer",
+				Description: "The issuer of the certificate.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("CertificateProperties.Issuer"),
+			},
+			{
+				Name:        "issue_date",
+				Description: "The date the certificate was issued.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("CertificateProperties.IssueDate").Transform(convertDateToTime),
+			},
+			{
+				Name:        "expiration_date",
+				Description: "The date the certificate expires.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("CertificateProperties.ExpirationDate").Transform(convertDateToTime),
+			},
+			{
+				Name:        "thumbprint",
+				Description: "The thumbprint of the certificate.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("CertificateProperties.Thumbprint"),
+			},
+			{
+				Name:        "valid",
+				Description: "Indicates whether the certificate is valid.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("CertificateProperties.Valid"),
+			},
+			{
+				Name:        "pfx_blob_in_bytes",
+				Description: "The size of the PFX blob of the certificate, in bytes. The blob itself is not returned.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("CertificateProperties.PfxBlob").Transform(extractAppServiceCertificatePfxBlobLength),
+			},
+			{
+				Name:        "public_key_hash",
+				Description: "The public key hash of the certificate.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("CertificateProperties.PublicKeyHash"),
+			},
+			{
+				Name:        "self_link",
+				Description: "The self link of the certificate.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("CertificateProperties.SelfLink"),
+			},
+			{
+				Name:        "site_name",
+				Description: "The name of the app the certificate was uploaded to.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("CertificateProperties.SiteName"),
+			},
+			{
+				Name:        "server_farm_id",
+				Description: "The resource ID of the associated App Service plan.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("CertificateProperties.ServerFarmID"),
+			},
+			{
+				Name:        "canonical_name",
+				Description: "The CNAME of the certificate to be issued via a free certificate.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("CertificateProperties.CanonicalName"),
+			},
+			{
+				Name:        "domain_validation_method",
+				Description: "The method of domain validation for a free certificate.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("CertificateProperties.DomainValidationMethod"),
+			},
+			{
+				Name:        "key_vault_id",
+				Description: "The resource ID of the key vault the certificate is stored in.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("CertificateProperties.KeyVaultID"),
+			},
+			{
+				Name:        "key_vault_secret_name",
+				Description: "The name of the key vault secret the certificate is stored in.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("CertificateProperties.KeyVaultSecretName"),
+			},
+			{
+				Name:        "key_vault_secret_status",
+				Description: "The status of the key vault secret, e.g. 'Succeeded' or 'KeyVaultSecretDoesNotExist'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("CertificateProperties.KeyVaultSecretStatus"),
+			},
+			{
+				Name:        "host_names",
+				Description: "The host names the certificate applies to.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("CertificateProperties.HostNames"),
+			},
+			{
+				Name:        "hosting_environment_profile",
+				Description: "The App Service environment used by the certificate.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("CertificateProperties.HostingEnvironmentProfile"),
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+			{
+				Name:        "tags",
+				Description: ColumnDescriptionTags,
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "akas",
+				Description: ColumnDescriptionAkas,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("ID").Transform(idToAkas),
+			},
+
+			// Azure standard columns
+			{
+				Name:        "region",
+				Description: ColumnDescriptionRegion,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Location").Transform(toLower),
+			},
+			{
+				Name:        "resource_group",
+				Description: ColumnDescriptionResourceGroup,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ID").Transform(extractResourceGroupFromID),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listAppServiceCertificates(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	session, err := GetNewSession(ctx, d, "MANAGEMENT")
+	if err != nil {
+		return nil, err
+	}
+	subscriptionID := session.SubscriptionID
+
+	client := web.NewCertificatesClientWithBaseURI(session.ResourceManagerEndpoint, subscriptionID)
+	client.Authorizer = session.Authorizer
+
+	result, err := client.List(ctx, "")
+	if err != nil {
+		plugin.Logger(ctx).Error("listAppServiceCertificates", "list", err)
+		return nil, err
+	}
+
+	for _, certificate := range result.Values() {
+		d.StreamListItem(ctx, certificate)
+		// Check if context has been cancelled or if the limit has been hit (if specified)
+		// if there is a limit, it will return the number of rows required to reach this limit
+		if d.RowsRemaining(ctx) == 0 {
+			return nil, nil
+		}
+	}
+
+	for result.NotDone() {
+		err = result.NextWithContext(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("listAppServiceCertificates", "list_paging", err)
+			return nil, err
+		}
+		for _, certificate := range result.Values() {
+			d.StreamListItem(ctx, certificate)
+			// Check if context has been cancelled or if the limit has been hit (if specified)
+			// if there is a limit, it will return the number of rows required to reach this limit
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, err
+}
+
+//// HYDRATE FUNCTIONS
+
+func getAppServiceCertificate(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("getAppServiceCertificate")
+
+	name := d.EqualsQuals["name"].GetStringValue()
+	resourceGroup := d.EqualsQuals["resource_group"].GetStringValue()
+
+	// Handle empty name or resourceGroup
+	if name == "" || resourceGroup == "" {
+		return nil, nil
+	}
+
+	session, err := GetNewSession(ctx, d, "MANAGEMENT")
+	if err != nil {
+		return nil, err
+	}
+	subscriptionID := session.SubscriptionID
+
+	client := web.NewCertificatesClientWithBaseURI(session.ResourceManagerEndpoint, subscriptionID)
+	client.Authorizer = session.Authorizer
+
+	op, err := client.Get(ctx, resourceGroup, name)
+	if err != nil {
+		plugin.Logger(ctx).Error("getAppServiceCertificate", "get", err)
+		return nil, err
+	}
+
+	// In some cases resource does not give any notFound error
+	// instead of notFound error, it returns empty data
+	if op.ID != nil {
+		return op, nil
+	}
+
+	return nil, nil
+}
+
+//// TRANSFORM FUNCTIONS
+
+// The PFX blob holds the private key of the certificate, so only its size is returned
+func extractAppServiceCertificatePfxBlobLength(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	blob, ok := d.Value.(*[]byte)
+	if !ok || blob == nil {
+		return nil, nil
+	}
+	return len(*blob), nil
+}
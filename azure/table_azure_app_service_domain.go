@@ -0,0 +1,271 @@
+package azure
+
+import (
+	"context"
+
+	"github.com/Azure/azure-sdk-for-go/profiles/latest/web/mgmt/web"
+	"github.com/turbot/go-kit/types"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+)
+
+//// TABLE DEFINITION
+
+func tableAzureAppServiceDomain(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "azure_app_service_domain",
+		Description: "Azure App Service Domain",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.AllColumns([]string{"name", "resource_group"}),
+			Hydrate:    getAppServiceDomain,
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: isNotFoundError([]string{"ResourceNotFound", "ResourceGroupNotFound", "404"}),
+			},
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listAppServiceDomains,
+		},
+		Columns: azureColumns([]*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name of the domain.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "id",
+				Description: "The resource ID of the domain.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromGo(),
+			},
+			{
+				Name:        "type",
+				Description: "The type of the resource.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "kind",
+				Description: "The kind of the resource.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "registration_status",
+				Description: "The registration status of the domain. Possible values include: 'Active', 'Awaiting', 'Cancelled', 'Expired', 'Locked', 'Pending', 'Transferred' and others.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("DomainProperties.RegistrationStatus"),
+			},
+			{
+				Name:        "provisioning_state",
+				Description: "The provisioning state of the domain. Possible values include: 'Succeeded', 'Failed', 'Canceled', 'InProgress', 'Deleting'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("DomainProperties.ProvisioningState"),
+			},
+			{
+				Name:        "privacy",
+				Description: "Indicates whether domain privacy is enabled for the domain.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("DomainProperties.Privacy"),
+			},
+			{
+				Name:        "auto_renew",
+				Description: "Indicates whether the domain is automatically renewed.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("DomainProperties.AutoRenew"),
+			},
+			{
+				Name:        "created_time",
+				Description: "The time the domain was created.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("DomainProperties.CreatedTime").Transform(convertDateToTime),
+			},
+			{
+				Name:        "expiration_time",
+				Description: "The time the domain expires.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("DomainProperties.ExpirationTime").Transform(convertDateToTime),
+			},
+			{
+				Name:        "last_renewed_time",
+				Description: "The time the domain was last renewed.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("DomainProperties.LastRenewedTime").Transform(convertDateToTime),
+			},
+			{
+				Name:        "ready_for_dns_record_management",
+				Description: "Indicates whether Azure can assign the domain to App Service apps. This is true when the domain registration status is active and it is hosted on name servers Azure has programmatic access to.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("DomainProperties.ReadyForDNSRecordManagement"),
+			},
+			{
+				Name:        "dns_type",
+				Description: "The current DNS type of the domain. Possible values include: 'AzureDNS', 'DefaultDomainRegistrarDNS'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("DomainProperties.DNSType"),
+			},
+			{
+				Name:        "dns_zone_id",
+				Description: "The resource ID of the Azure DNS zone of the domain.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("DomainProperties.DNSZoneID"),
+			},
+			{
+				Name:        "target_dns_type",
+				Description: "The target DNS type the domain is being moved to. Possible values include: 'AzureDNS', 'DefaultDomainRegistrarDNS'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("DomainProperties.TargetDNSType"),
+			},
+			{
+				Name:        "auth_code",
+				Description: "Indicates whether the domain has an authorization code for transfers. The code itself is masked.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("DomainProperties.AuthCode").Transform(maskAppServiceDomainAuthCode),
+			},
+			{
+				Name:        "name_servers",
+				Description: "The name servers of the domain.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("DomainProperties.NameServers"),
+			},
+			{
+				Name:        "domain_not_renewable_reasons",
+				Description: "The reasons why the domain is not renewable.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("DomainProperties.DomainNotRenewableReasons"),
+			},
+			{
+				Name:        "managed_host_names",
+				Description: "All the host names derived from the domain and assigned to Azure resources.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("DomainProperties.ManagedHostNames"),
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+			{
+				Name:        "tags",
+				Description: ColumnDescriptionTags,
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "akas",
+				Description: ColumnDescriptionAkas,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("ID").Transform(idToAkas),
+			},
+
+			// Azure standard columns
+			{
+				Name:        "region",
+				Description: ColumnDescriptionRegion,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Location").Transform(toLower),
+			},
+			{
+				Name:        "resource_group",
+				Description: ColumnDescriptionResourceGroup,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ID").Transform(extractResourceGroupFromID),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listAppServiceDomains(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	session, err := GetNewSession(ctx, d, "MANAGEMENT")
+	if err != nil {
+		return nil, err
+	}
+	subscriptionID := session.SubscriptionID
+
+	client := web.NewDomainsClientWithBaseURI(session.ResourceManagerEndpoint, subscriptionID)
+	client.Authorizer = session.Authorizer
+
+	result, err := client.List(ctx)
+	if err != nil {
+		plugin.Logger(ctx).Error("listAppServiceDomains", "list", err)
+		return nil, err
+	}
+
+	for _, domain := range result.Values() {
+		d.StreamListItem(ctx, domain)
+		// Check if context has been cancelled or if the limit has been hit (if specified)
+		// if there is a limit, it will return the number of rows required to reach this limit
+		if d.RowsRemaining(ctx) == 0 {
+			return nil, nil
+		}
+	}
+
+	for result.NotDone() {
+		err = result.NextWithContext(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("listAppServiceDomains", "list_paging", err)
+			return nil, err
+		}
+		for _, domain := range result.Values() {
+			d.StreamListItem(ctx, domain)
+			// Check if context has been cancelled or if the limit has been hit (if specified)
+			// if there is a limit, it will return the number of rows required to reach this limit
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, err
+}
+
+//// HYDRATE FUNCTIONS
+
+func getAppServiceDomain(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("getAppServiceDomain")
+
+	name := d.EqualsQuals["name"].GetStringValue()
+	resourceGroup := d.EqualsQuals["resource_group"].GetStringValue()
+
+	// Handle empty name or resourceGroup
+	if name == "" || resourceGroup == "" {
+		return nil, nil
+	}
+
+	session, err := GetNewSession(ctx, d, "MANAGEMENT")
+	if err != nil {
+		return nil, err
+	}
+	subscriptionID := session.SubscriptionID
+
+	client := web.NewDomainsClientWithBaseURI(session.ResourceManagerEndpoint, subscriptionID)
+	client.Authorizer = session.Authorizer
+
+	op, err := client.Get(ctx, resourceGroup, name)
+	if err != nil {
+		plugin.Logger(ctx).Error("getAppServiceDomain", "get", err)
+		return nil, err
+	}
+
+	// In some cases resource does not give any notFound error
+	// instead of notFound error, it returns empty data
+	if op.ID != nil {
+		return op, nil
+	}
+
+	return nil, nil
+}
+
+//// TRANSFORM FUNCTIONS
+
+// The authorization code allows the domain to be transferred to another registrar, so it is masked
+func maskAppServiceDomainAuthCode(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	authCode := types.SafeString(d.Value)
+	if authCode == "" {
+		return nil, nil
+	}
+	return "********", nil
+}
@@ -2,6 +2,7 @@ package azure
 
 import (
 	"context"
+	"sort"
 	"strings"
 
 	"github.com/Azure/azure-sdk-for-go/profiles/latest/web/mgmt/web"
@@ -11,6 +12,15 @@ import (
 	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
 )
 
+// functionAppRuntimeSettings holds the runtime details of a function app that are read from its
+// application settings. Only these values are kept, since the other settings may contain secrets.
+type functionAppRuntimeSettings struct {
+	RuntimeStack              *string
+	RuntimeVersion            *string
+	FunctionsExtensionVersion *string
+	StorageAccountName        *string
+}
+
 //// TABLE DEFINITION
 
 func tableAzureAppServiceFunctionApp(_ context.Context) *plugin.Table {
@@ -27,6 +37,12 @@ func tableAzureAppServiceFunctionApp(_ context.Context) *plugin.Table {
 		List: &plugin.ListConfig{
 			Hydrate: listAppServiceFunctionApps,
 		},
+		HydrateConfig: []plugin.HydrateConfig{
+			{
+				Func:    getAppServiceFunctionAppRuntimeSettings,
+				Depends: []plugin.HydrateFunc{getAppServiceFunctionAppSiteConfiguration},
+			},
+		},
 		Columns: azureColumns([]*plugin.Column{
 			{
 				Name:        "name",
@@ -115,6 +131,55 @@ func tableAzureAppServiceFunctionApp(_ context.Context) *plugin.Table {
 				Type:        proto.ColumnType_JSON,
 				Transform:   transform.FromField("SiteProperties.HostNames"),
 			},
+			{
+				Name:        "always_on",
+				Description: "Specifies whether the app is kept loaded even when there is no traffic.",
+				Type:        proto.ColumnType_BOOL,
+				Hydrate:     getAppServiceFunctionAppSiteConfiguration,
+				Transform:   transform.FromField("SiteConfig.AlwaysOn"),
+			},
+			{
+				Name:        "runtime_stack",
+				Description: "The language worker runtime of the functions, e.g. 'dotnet', 'node', 'python', 'java' or 'powershell'.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getAppServiceFunctionAppRuntimeSettings,
+				Transform:   transform.FromField("RuntimeStack"),
+			},
+			{
+				Name:        "runtime_version",
+				Description: "The version of the language worker runtime of the functions.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getAppServiceFunctionAppRuntimeSettings,
+				Transform:   transform.FromField("RuntimeVersion"),
+			},
+			{
+				Name:        "functions_extension_version",
+				Description: "The version of the Functions runtime that hosts the app, e.g. '~4'.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getAppServiceFunctionAppRuntimeSettings,
+				Transform:   transform.FromField("FunctionsExtensionVersion"),
+			},
+			{
+				Name:        "storage_account_name",
+				Description: "The name of the storage account used by the Functions runtime.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getAppServiceFunctionAppRuntimeSettings,
+				Transform:   transform.FromField("StorageAccountName"),
+			},
+			{
+				Name:        "function_count",
+				Description: "The number of functions in the app.",
+				Type:        proto.ColumnType_INT,
+				Hydrate:     getAppServiceFunctionAppFunctionCount,
+				Transform:   transform.FromValue(),
+			},
+			{
+				Name:        "host_keys",
+				Description: "The names of the host level function keys and system keys of the app. The key values are not included.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     listAppServiceFunctionAppHostKeys,
+				Transform:   transform.FromValue(),
+			},
 			{
 				Name:        "auth_settings",
 				Description: "Describes the Authentication/Authorization settings of an app.",
@@ -302,3 +367,125 @@ func getAppServiceFunctionAppSiteAuthSetting(ctx context.Context, d *plugin.Quer
 
 	return op, nil
 }
+
+func getAppServiceFunctionAppRuntimeSettings(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	data := h.Item.(web.Site)
+
+	session, err := GetNewSession(ctx, d, "MANAGEMENT")
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_app_service_function_app.getAppServiceFunctionAppRuntimeSettings", "session_error", err)
+		return nil, err
+	}
+	subscriptionID := session.SubscriptionID
+
+	webClient := web.NewAppsClientWithBaseURI(session.ResourceManagerEndpoint, subscriptionID)
+	webClient.Authorizer = session.Authorizer
+
+	op, err := webClient.ListApplicationSettings(ctx, *data.SiteProperties.ResourceGroup, *data.Name)
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_app_service_function_app.getAppServiceFunctionAppRuntimeSettings", "api_error", err)
+		return nil, err
+	}
+
+	settings := functionAppRuntimeSettings{
+		RuntimeStack:              op.Properties["FUNCTIONS_WORKER_RUNTIME"],
+		FunctionsExtensionVersion: op.Properties["FUNCTIONS_EXTENSION_VERSION"],
+		StorageAccountName:        op.Properties["AzureWebJobsStorage__accountName"],
+	}
+
+	// Linux apps set the runtime version in the site configuration, e.g. "Python|3.9",
+	// while Windows apps set it in the application settings
+	if config, ok := h.HydrateResults["getAppServiceFunctionAppSiteConfiguration"].(web.SiteConfigResource); ok && config.SiteConfig != nil && config.LinuxFxVersion != nil {
+		if parts := strings.Split(*config.LinuxFxVersion, "|"); len(parts) == 2 {
+			settings.RuntimeVersion = &parts[1]
+		}
+	}
+	if settings.RuntimeVersion == nil {
+		settings.RuntimeVersion = op.Properties["FUNCTIONS_WORKER_RUNTIME_VERSION"]
+	}
+	if settings.RuntimeVersion == nil {
+		settings.RuntimeVersion = op.Properties["WEBSITE_NODE_DEFAULT_VERSION"]
+	}
+
+	// The storage account is usually referenced through a connection string, of which only the account name is kept
+	if settings.StorageAccountName == nil && op.Properties["AzureWebJobsStorage"] != nil {
+		for _, part := range strings.Split(*op.Properties["AzureWebJobsStorage"], ";") {
+			if strings.HasPrefix(part, "AccountName=") {
+				accountName := strings.TrimPrefix(part, "AccountName=")
+				settings.StorageAccountName = &accountName
+				break
+			}
+		}
+	}
+
+	return settings, nil
+}
+
+func getAppServiceFunctionAppFunctionCount(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	data := h.Item.(web.Site)
+
+	session, err := GetNewSession(ctx, d, "MANAGEMENT")
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_app_service_function_app.getAppServiceFunctionAppFunctionCount", "session_error", err)
+		return nil, err
+	}
+	subscriptionID := session.SubscriptionID
+
+	webClient := web.NewAppsClientWithBaseURI(session.ResourceManagerEndpoint, subscriptionID)
+	webClient.Authorizer = session.Authorizer
+
+	result, err := webClient.ListFunctions(ctx, *data.SiteProperties.ResourceGroup, *data.Name)
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_app_service_function_app.getAppServiceFunctionAppFunctionCount", "api_error", err)
+		return nil, err
+	}
+
+	count := len(result.Values())
+	for result.NotDone() {
+		err = result.NextWithContext(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("azure_app_service_function_app.getAppServiceFunctionAppFunctionCount", "api_paging_error", err)
+			return nil, err
+		}
+		count += len(result.Values())
+	}
+
+	return count, nil
+}
+
+func listAppServiceFunctionAppHostKeys(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	data := h.Item.(web.Site)
+
+	session, err := GetNewSession(ctx, d, "MANAGEMENT")
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_app_service_function_app.listAppServiceFunctionAppHostKeys", "session_error", err)
+		return nil, err
+	}
+	subscriptionID := session.SubscriptionID
+
+	webClient := web.NewAppsClientWithBaseURI(session.ResourceManagerEndpoint, subscriptionID)
+	webClient.Authorizer = session.Authorizer
+
+	op, err := webClient.ListHostKeys(ctx, *data.SiteProperties.ResourceGroup, *data.Name)
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_app_service_function_app.listAppServiceFunctionAppHostKeys", "api_error", err)
+		return nil, err
+	}
+
+	// Only the key names are returned, the key values are secrets
+	functionKeys := []string{}
+	for name := range op.FunctionKeys {
+		functionKeys = append(functionKeys, name)
+	}
+	systemKeys := []string{}
+	for name := range op.SystemKeys {
+		systemKeys = append(systemKeys, name)
+	}
+	sort.Strings(functionKeys)
+	sort.Strings(systemKeys)
+
+	return map[string]interface{}{
+		"function_keys": functionKeys,
+		"system_keys":   systemKeys,
+	}, nil
+}
@@ -0,0 +1,39 @@
+package azure
+
+import (
+	"context"
+
+	"github.com/Azure/azure-sdk-for-go/profiles/latest/web/mgmt/web"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAzureAppServiceMetricHttp5xxHourly(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "azure_app_service_metric_http5xx_hourly",
+		Description: "Azure App Service Web App Metrics - HTTP 5xx Errors (Hourly)",
+		List: &plugin.ListConfig{
+			ParentHydrate: listAppServiceWebApps,
+			Hydrate:       listAppServiceMetricHttp5xxHourly,
+		},
+		Columns: monitoringMetricColumns([]*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name of the web app.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("DimensionValue").Transform(lastPathElement),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listAppServiceMetricHttp5xxHourly(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	webAppInfo := h.Item.(web.Site)
+
+	return listAzureMonitorMetricStatistics(ctx, d, "HOURLY", "Microsoft.Web/sites", "Http5xx", *webAppInfo.ID)
+}
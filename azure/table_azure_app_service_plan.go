@@ -27,6 +27,12 @@ func tableAzureAppServicePlan(_ context.Context) *plugin.Table {
 		},
 		List: &plugin.ListConfig{
 			Hydrate: listAppServicePlans,
+			KeyColumns: plugin.KeyColumnSlice{
+				{
+					Name:    "resource_group",
+					Require: plugin.Optional,
+				},
+			},
 		},
 		Columns: azureColumns([]*plugin.Column{
 			{
@@ -50,6 +56,18 @@ func tableAzureAppServicePlan(_ context.Context) *plugin.Table {
 				Description: "The resource type of the app service plan",
 				Type:        proto.ColumnType_STRING,
 			},
+			{
+				Name:        "elastic_scale_enabled",
+				Description: "Specifies whether the plan can elastically scale out. Only applies to elastic premium plans.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("AppServicePlanProperties.ElasticScaleEnabled"),
+			},
+			{
+				Name:        "geo_region",
+				Description: "Geographical location of the App Service plan.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("AppServicePlanProperties.GeoRegion"),
+			},
 			{
 				Name:        "hyper_v",
 				Description: "Specify whether resource is Hyper-V container app service plan",
@@ -83,6 +101,12 @@ func tableAzureAppServicePlan(_ context.Context) *plugin.Table {
 				Type:        proto.ColumnType_INT,
 				Transform:   transform.FromField("AppServicePlanProperties.MaximumNumberOfWorkers"),
 			},
+			{
+				Name:        "number_of_sites",
+				Description: "The number of apps assigned to the App Service plan.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("AppServicePlanProperties.NumberOfSites"),
+			},
 			{
 				Name:        "per_site_scaling",
 				Description: "Specify whether apps assigned to this App Service plan can be scaled independently",
@@ -139,6 +163,30 @@ func tableAzureAppServicePlan(_ context.Context) *plugin.Table {
 				Type:        proto.ColumnType_STRING,
 				Transform:   transform.FromField("AppServicePlanProperties.Status").Transform(transform.ToString),
 			},
+			{
+				Name:        "target_worker_count",
+				Description: "The target number of workers of the App Service plan.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("AppServicePlanProperties.TargetWorkerCount"),
+			},
+			{
+				Name:        "target_worker_size_id",
+				Description: "The target worker size ID of the App Service plan.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("AppServicePlanProperties.TargetWorkerSizeID"),
+			},
+			{
+				Name:        "worker_tier_name",
+				Description: "The target worker tier of the App Service plan.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("AppServicePlanProperties.WorkerTierName"),
+			},
+			{
+				Name:        "zone_redundant",
+				Description: "Specifies whether the App Service plan is zone redundant, with its instances spread across availability zones.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("AppServicePlanProperties.ZoneRedundant"),
+			},
 			{
 				Name:        "apps",
 				Description: "Site a web app, a mobile app backend, or an API app.",
@@ -146,6 +194,13 @@ func tableAzureAppServicePlan(_ context.Context) *plugin.Table {
 				Hydrate:     getServicePlanApps,
 				Transform:   transform.FromValue(),
 			},
+			{
+				Name:        "web_apps_count",
+				Description: "The number of apps in the App Service plan.",
+				Type:        proto.ColumnType_INT,
+				Hydrate:     getServicePlanApps,
+				Transform:   transform.From(appServicePlanAppsCount),
+			},
 
 			// Steampipe standard columns
 			{
@@ -195,7 +250,12 @@ func listAppServicePlans(ctx context.Context, d *plugin.QueryData, _ *plugin.Hyd
 	webClient := web.NewAppServicePlansClientWithBaseURI(session.ResourceManagerEndpoint, subscriptionID)
 	webClient.Authorizer = session.Authorizer
 
-	result, err := webClient.List(ctx, types.Bool(true))
+	var result web.AppServicePlanCollectionPage
+	if resourceGroup := d.EqualsQualString("resource_group"); resourceGroup != "" {
+		result, err = webClient.ListByResourceGroup(ctx, resourceGroup)
+	} else {
+		result, err = webClient.List(ctx, types.Bool(true))
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -339,3 +399,13 @@ func getServicePlanApps(ctx context.Context, d *plugin.QueryData, h *plugin.Hydr
 
 	return apps, nil
 }
+
+//// TRANSFORM FUNCTIONS
+
+func appServicePlanAppsCount(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	apps, ok := d.HydrateItem.([]AppServicePlanApp)
+	if !ok {
+		return 0, nil
+	}
+	return len(apps), nil
+}
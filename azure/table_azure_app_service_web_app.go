@@ -2,6 +2,7 @@ package azure
 
 import (
 	"context"
+	"strings"
 
 	"github.com/Azure/azure-sdk-for-go/profiles/latest/web/mgmt/web"
 	"github.com/turbot/go-kit/types"
@@ -127,6 +128,42 @@ func tableAzureAppServiceWebApp(_ context.Context) *plugin.Table {
 				Type:        proto.ColumnType_JSON,
 				Transform:   transform.FromField("SiteProperties.HostNames"),
 			},
+			{
+				Name:        "availability_state",
+				Description: "Management information availability state for the app. Possible values include: 'Normal', 'Limited', 'DisasterRecoveryMode'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("SiteProperties.AvailabilityState"),
+			},
+			{
+				Name:        "usage_state",
+				Description: "State indicating whether the app has exceeded its quota usage. Possible values include: 'Normal', 'Exceeded'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("SiteProperties.UsageState"),
+			},
+			{
+				Name:        "repository_site_name",
+				Description: "Name of the repository site.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("SiteProperties.RepositorySiteName"),
+			},
+			{
+				Name:        "server_farm_id",
+				Description: "Resource ID of the associated App Service plan.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("SiteProperties.ServerFarmID"),
+			},
+			{
+				Name:        "redundancy_mode",
+				Description: "Site redundancy mode. Possible values include: 'None', 'Manual', 'Failover', 'ActiveActive', 'GeoRedundant'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("SiteProperties.RedundancyMode"),
+			},
+			{
+				Name:        "virtual_network_subnet_id",
+				Description: "Azure Resource Manager ID of the virtual network and subnet to be joined by regional VNet integration.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("SiteProperties.VirtualNetworkSubnetID"),
+			},
 			{
 				Name:        "auth_settings",
 				Description: "Describes the Authentication/Authorization settings of an app.",
@@ -223,7 +260,7 @@ func listAppServiceWebApps(ctx context.Context, d *plugin.QueryData, _ *plugin.H
 	}
 	for _, webApp := range result.Values() {
 		// Filtering out all the function apps
-		if string(*webApp.Kind) != "functionapp" {
+		if !strings.Contains(string(*webApp.Kind), "functionapp") {
 			d.StreamListItem(ctx, webApp)
 		}
 		// Check if context has been cancelled or if the limit has been hit (if specified)
@@ -241,7 +278,7 @@ func listAppServiceWebApps(ctx context.Context, d *plugin.QueryData, _ *plugin.H
 
 		for _, webApp := range result.Values() {
 			// Filtering out all the function apps
-			if string(*webApp.Kind) != "functionapp" {
+			if !strings.Contains(string(*webApp.Kind), "functionapp") {
 				d.StreamListItem(ctx, webApp)
 			}
 			// Check if context has been cancelled or if the limit has been hit (if specified)
@@ -284,7 +321,7 @@ func getAppServiceWebApp(ctx context.Context, d *plugin.QueryData, h *plugin.Hyd
 
 	// In some cases resource does not give any notFound error
 	// instead of notFound error, it returns empty data
-	if op.ID != nil && string(*op.Kind) != "functionapp" {
+	if op.ID != nil && !strings.Contains(string(*op.Kind), "functionapp") {
 		return op, nil
 	}
 
@@ -29,6 +29,13 @@ func tableAzureApplicationGateway(_ context.Context) *plugin.Table {
 		List: &plugin.ListConfig{
 			Hydrate: listApplicationGateways,
 		},
+		HydrateConfig: []plugin.HydrateConfig{
+			{
+				Func:           listApplicationGatewayDiagnosticSettings,
+				MaxConcurrency: diagnosticSettingsMaxConcurrency,
+				Tags:           map[string]string{"service": "Microsoft.Insights", "action": "diagnosticSettings/list"},
+			},
+		},
 		Columns: azureColumns([]*plugin.Column{
 			{
 				Name:        "name",
@@ -207,6 +214,24 @@ func tableAzureApplicationGateway(_ context.Context) *plugin.Table {
 				Type:        proto.ColumnType_JSON,
 				Transform:   transform.FromField("ApplicationGatewayPropertiesFormat.Sku"),
 			},
+			{
+				Name:        "sku_name",
+				Description: "Name of the application gateway SKU.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ApplicationGatewayPropertiesFormat.Sku.Name"),
+			},
+			{
+				Name:        "sku_tier",
+				Description: "Tier of the application gateway SKU.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ApplicationGatewayPropertiesFormat.Sku.Tier"),
+			},
+			{
+				Name:        "sku_capacity",
+				Description: "Capacity (instance count) of the application gateway.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("ApplicationGatewayPropertiesFormat.Sku.Capacity"),
+			},
 			{
 				Name:        "ssl_certificates",
 				Description: "SSL certificates of the application gateway.",
@@ -0,0 +1,146 @@
+package azure
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/profiles/latest/appinsights/mgmt/insights"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+)
+
+//// TABLE DEFINITION ////
+
+func tableAzureApplicationInsightsAPIKey(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "azure_application_insights_api_key",
+		Description: "Azure Application Insights API Key",
+		List: &plugin.ListConfig{
+			Hydrate:       listApplicationInsightsAPIKeys,
+			ParentHydrate: listApplicationInsights,
+		},
+		Columns: azureColumns([]*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name of the API key.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "id",
+				Description: "The unique ID of the API key inside the Application Insights component.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromGo(),
+			},
+			{
+				Name:        "app_component_name",
+				Description: "The name of the Application Insights component the API key belongs to.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "created_date",
+				Description: "The creation date of the API key.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("CreatedDate").Transform(convertApplicationInsightsAPIKeyCreatedDate),
+			},
+			{
+				Name:        "linked_read_properties",
+				Description: "The read access rights of the API key.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "linked_write_properties",
+				Description: "The write access rights of the API key.",
+				Type:        proto.ColumnType_JSON,
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+			{
+				Name:        "akas",
+				Description: ColumnDescriptionAkas,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("ID").Transform(idToAkas),
+			},
+
+			// Azure standard columns
+			{
+				Name:        "resource_group",
+				Description: ColumnDescriptionResourceGroup,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ID").Transform(extractResourceGroupFromID),
+			},
+		}),
+	}
+}
+
+type ApplicationInsightsAPIKeyInfo = struct {
+	insights.ApplicationInsightsComponentAPIKey
+	AppComponentName *string
+}
+
+//// LIST FUNCTION ////
+
+func listApplicationInsightsAPIKeys(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	logger := plugin.Logger(ctx)
+
+	component := h.Item.(insights.ApplicationInsightsComponent)
+	resourceGroup := strings.Split(*component.ID, "/")[4]
+
+	session, err := GetNewSession(ctx, d, "MANAGEMENT")
+	if err != nil {
+		logger.Error("azure_application_insights_api_key.listApplicationInsightsAPIKeys", "connection_error", err)
+		return nil, err
+	}
+	subscriptionID := session.SubscriptionID
+
+	apiKeyClient := insights.NewAPIKeysClientWithBaseURI(session.ResourceManagerEndpoint, subscriptionID)
+	apiKeyClient.Authorizer = session.Authorizer
+
+	// The API does not support paging
+	result, err := apiKeyClient.List(ctx, resourceGroup, *component.Name)
+	if err != nil {
+		logger.Error("azure_application_insights_api_key.listApplicationInsightsAPIKeys", "api_error", err)
+		return nil, err
+	}
+
+	if result.Value == nil {
+		return nil, nil
+	}
+
+	for _, apiKey := range *result.Value {
+		d.StreamListItem(ctx, ApplicationInsightsAPIKeyInfo{apiKey, component.Name})
+		// Check if context has been cancelled or if the limit has been hit (if specified)
+		// if there is a limit, it will return the number of rows required to reach this limit
+		if d.RowsRemaining(ctx) == 0 {
+			return nil, nil
+		}
+	}
+
+	return nil, nil
+}
+
+//// TRANSFORM FUNCTIONS ////
+
+// The created date of an API key is returned as a string, e.g. "Thu, 06 Apr 2023 09:08:41 GMT"
+func convertApplicationInsightsAPIKeyCreatedDate(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	createdDate, ok := d.Value.(*string)
+	if !ok || createdDate == nil || *createdDate == "" {
+		return nil, nil
+	}
+
+	for _, layout := range []string{time.RFC1123, time.RFC1123Z, time.RFC3339} {
+		if t, err := time.Parse(layout, *createdDate); err == nil {
+			return t.Format(time.RFC3339), nil
+		}
+	}
+
+	return nil, nil
+}
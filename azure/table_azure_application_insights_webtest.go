@@ -0,0 +1,301 @@
+package azure
+
+import (
+	"context"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/profiles/latest/appinsights/mgmt/insights"
+	"github.com/Azure/azure-sdk-for-go/profiles/latest/resources/mgmt/resources"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+)
+
+// The web tests API version of the Azure SDK used by the plugin does not return
+// the request and validation rules of standard tests, so they are read as a generic resource
+const applicationInsightsWebTestAPIVersion = "2022-06-15"
+
+//// TABLE DEFINITION ////
+
+func tableAzureApplicationInsightsWebTest(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "azure_application_insights_web_test",
+		Description: "Azure Application Insights Web Test",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.AllColumns([]string{"name", "resource_group"}),
+			Hydrate:    getApplicationInsightsWebTest,
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: isNotFoundError([]string{"ResourceNotFound", "ResourceGroupNotFound", "404"}),
+			},
+		},
+		List: &plugin.ListConfig{
+			Hydrate:       listApplicationInsightsWebTests,
+			ParentHydrate: listApplicationInsights,
+		},
+		Columns: azureColumns([]*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name of the web test.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "id",
+				Description: "The ID of the web test.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromGo(),
+			},
+			{
+				Name:        "type",
+				Description: "The type of the resource.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "kind",
+				Description: "The kind of web test. Possible values include: 'ping', 'multistep'.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "synthetic_monitor_id",
+				Description: "The unique ID of the web test. This is typically the same value as the name field.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("WebTestProperties.SyntheticMonitorID"),
+			},
+			{
+				Name:        "web_test_name",
+				Description: "The user defined name of the web test.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("WebTestProperties.WebTestName"),
+			},
+			{
+				Name:        "description",
+				Description: "The user defined description of the web test.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("WebTestProperties.Description"),
+			},
+			{
+				Name:        "enabled",
+				Description: "Indicates whether the test is actively being monitored.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("WebTestProperties.Enabled"),
+			},
+			{
+				Name:        "frequency",
+				Description: "The interval in seconds between test runs for the web test. Default value is 300.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("WebTestProperties.Frequency"),
+			},
+			{
+				Name:        "timeout",
+				Description: "The number of seconds until the web test times out and fails. Default value is 30.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("WebTestProperties.Timeout"),
+			},
+			{
+				Name:        "retry_enabled",
+				Description: "Indicates whether retries are allowed if the web test fails.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("WebTestProperties.RetryEnabled"),
+			},
+			{
+				Name:        "provisioning_state",
+				Description: "The current provisioning state of the web test. Values will include Succeeded, Deploying, Canceled, and Failed.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("WebTestProperties.ProvisioningState"),
+			},
+			{
+				Name:        "app_component_id",
+				Description: "The ID of the Application Insights component the web test belongs to.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("AppComponentID"),
+			},
+			{
+				Name:        "locations",
+				Description: "A list of locations the web test physically runs from.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("WebTestProperties.Locations"),
+			},
+			{
+				Name:        "configuration",
+				Description: "The XML specification of the web test to run against an application.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("WebTestProperties.Configuration"),
+			},
+			{
+				Name:        "request",
+				Description: "The collection of request properties of a standard web test.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getApplicationInsightsWebTestProperties,
+				Transform:   transform.FromField("Properties").TransformP(extractGenericResourceProperty, "Request"),
+			},
+			{
+				Name:        "validation_rules",
+				Description: "The collection of validation rule properties of a standard web test.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getApplicationInsightsWebTestProperties,
+				Transform:   transform.FromField("Properties").TransformP(extractGenericResourceProperty, "ValidationRules"),
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+			{
+				Name:        "tags",
+				Description: ColumnDescriptionTags,
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "akas",
+				Description: ColumnDescriptionAkas,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("ID").Transform(idToAkas),
+			},
+
+			// Azure standard columns
+			{
+				Name:        "region",
+				Description: ColumnDescriptionRegion,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Location").Transform(toLower),
+			},
+			{
+				Name:        "resource_group",
+				Description: ColumnDescriptionResourceGroup,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ID").Transform(extractResourceGroupFromID),
+			},
+		}),
+	}
+}
+
+type ApplicationInsightsWebTestInfo = struct {
+	insights.WebTest
+	AppComponentID *string
+}
+
+//// LIST FUNCTION ////
+
+func listApplicationInsightsWebTests(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	logger := plugin.Logger(ctx)
+
+	component := h.Item.(insights.ApplicationInsightsComponent)
+	resourceGroup := strings.Split(*component.ID, "/")[4]
+
+	session, err := GetNewSession(ctx, d, "MANAGEMENT")
+	if err != nil {
+		logger.Error("azure_application_insights_web_test.listApplicationInsightsWebTests", "connection_error", err)
+		return nil, err
+	}
+	subscriptionID := session.SubscriptionID
+
+	webTestClient := insights.NewWebTestsClientWithBaseURI(session.ResourceManagerEndpoint, subscriptionID)
+	webTestClient.Authorizer = session.Authorizer
+
+	result, err := webTestClient.ListByComponent(ctx, *component.Name, resourceGroup)
+	if err != nil {
+		logger.Error("azure_application_insights_web_test.listApplicationInsightsWebTests", "api_error", err)
+		return nil, err
+	}
+
+	for _, webTest := range result.Values() {
+		d.StreamListItem(ctx, ApplicationInsightsWebTestInfo{webTest, component.ID})
+		// Check if context has been cancelled or if the limit has been hit (if specified)
+		// if there is a limit, it will return the number of rows required to reach this limit
+		if d.RowsRemaining(ctx) == 0 {
+			return nil, nil
+		}
+	}
+
+	for result.NotDone() {
+		err = result.NextWithContext(ctx)
+		if err != nil {
+			logger.Error("azure_application_insights_web_test.listApplicationInsightsWebTests", "paging_error", err)
+			return nil, err
+		}
+		for _, webTest := range result.Values() {
+			d.StreamListItem(ctx, ApplicationInsightsWebTestInfo{webTest, component.ID})
+			// Check if context has been cancelled or if the limit has been hit (if specified)
+			// if there is a limit, it will return the number of rows required to reach this limit
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS ////
+
+func getApplicationInsightsWebTest(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	logger := plugin.Logger(ctx)
+
+	name := d.EqualsQuals["name"].GetStringValue()
+	resourceGroup := d.EqualsQuals["resource_group"].GetStringValue()
+
+	if name == "" || resourceGroup == "" {
+		return nil, nil
+	}
+
+	session, err := GetNewSession(ctx, d, "MANAGEMENT")
+	if err != nil {
+		logger.Error("azure_application_insights_web_test.getApplicationInsightsWebTest", "connection_error", err)
+		return nil, err
+	}
+	subscriptionID := session.SubscriptionID
+
+	webTestClient := insights.NewWebTestsClientWithBaseURI(session.ResourceManagerEndpoint, subscriptionID)
+	webTestClient.Authorizer = session.Authorizer
+
+	op, err := webTestClient.Get(ctx, resourceGroup, name)
+	if err != nil {
+		logger.Error("azure_application_insights_web_test.getApplicationInsightsWebTest", "api_error", err)
+		return nil, err
+	}
+
+	// In some cases resource does not give any notFound error
+	// instead of notFound error, it returns empty data
+	if op.ID == nil {
+		return nil, nil
+	}
+
+	// Web tests are linked to their component through a "hidden-link:<component ID>" tag
+	var componentID *string
+	for key := range op.Tags {
+		if strings.HasPrefix(key, "hidden-link:") {
+			id := strings.TrimPrefix(key, "hidden-link:")
+			componentID = &id
+			break
+		}
+	}
+
+	return ApplicationInsightsWebTestInfo{op, componentID}, nil
+}
+
+func getApplicationInsightsWebTestProperties(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	logger := plugin.Logger(ctx)
+
+	webTest := h.Item.(ApplicationInsightsWebTestInfo)
+
+	session, err := GetNewSession(ctx, d, "MANAGEMENT")
+	if err != nil {
+		logger.Error("azure_application_insights_web_test.getApplicationInsightsWebTestProperties", "connection_error", err)
+		return nil, err
+	}
+	subscriptionID := session.SubscriptionID
+
+	client := resources.NewClientWithBaseURI(session.ResourceManagerEndpoint, subscriptionID)
+	client.Authorizer = session.Authorizer
+
+	op, err := client.GetByID(ctx, *webTest.ID, applicationInsightsWebTestAPIVersion)
+	if err != nil {
+		logger.Error("azure_application_insights_web_test.getApplicationInsightsWebTestProperties", "api_error", err)
+		return nil, err
+	}
+
+	return op, nil
+}
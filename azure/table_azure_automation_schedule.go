@@ -0,0 +1,278 @@
+package azure
+
+import (
+	"context"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/profiles/latest/automation/mgmt/automation"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+)
+
+//// TABLE DEFINITION ////
+
+func tableAzureAutomationSchedule(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "azure_automation_schedule",
+		Description: "Azure Automation Schedule",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.AllColumns([]string{"account_name", "name", "resource_group"}),
+			Hydrate:    getAutomationSchedule,
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: isNotFoundError([]string{"ResourceNotFound", "ResourceGroupNotFound", "404"}),
+			},
+		},
+		List: &plugin.ListConfig{
+			ParentHydrate: listAutomationAccounts,
+			Hydrate:       listAutomationSchedules,
+		},
+		Columns: azureColumns([]*plugin.Column{
+			{
+				Name:        "name",
+				Type:        proto.ColumnType_STRING,
+				Description: "The name of the resource.",
+			},
+			{
+				Name:        "account_name",
+				Type:        proto.ColumnType_STRING,
+				Description: "The name of the automation account the schedule belongs to.",
+			},
+			{
+				Name:        "id",
+				Description: "Fully qualified resource ID.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromGo(),
+			},
+			{
+				Name:        "description",
+				Description: "The description of the schedule.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ScheduleProperties.Description"),
+			},
+			{
+				Name:        "type",
+				Description: "The type of the resource.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "is_enabled",
+				Description: "Indicates whether the schedule is enabled.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("ScheduleProperties.IsEnabled"),
+			},
+			{
+				Name:        "frequency",
+				Description: "The frequency of the schedule. Possible values include: 'OneTime', 'Day', 'Hour', 'Week', 'Month', 'Minute'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ScheduleProperties.Frequency"),
+			},
+			{
+				Name:        "interval",
+				Description: "The interval of the schedule, in units of the frequency.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("ScheduleProperties.Interval"),
+			},
+			{
+				Name:        "time_zone",
+				Description: "The time zone of the schedule.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ScheduleProperties.TimeZone"),
+			},
+			{
+				Name:        "start_time",
+				Description: "The start time of the schedule.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("ScheduleProperties.StartTime.Time"),
+			},
+			{
+				Name:        "start_time_offset_minutes",
+				Description: "The start time's offset in minutes.",
+				Type:        proto.ColumnType_DOUBLE,
+				Transform:   transform.FromField("ScheduleProperties.StartTimeOffsetMinutes"),
+			},
+			{
+				Name:        "expiry_time",
+				Description: "The end time of the schedule.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("ScheduleProperties.ExpiryTime.Time"),
+			},
+			{
+				Name:        "expiry_time_offset_minutes",
+				Description: "The expiry time's offset in minutes.",
+				Type:        proto.ColumnType_DOUBLE,
+				Transform:   transform.FromField("ScheduleProperties.ExpiryTimeOffsetMinutes"),
+			},
+			{
+				Name:        "next_run",
+				Description: "The next run time of the schedule.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("ScheduleProperties.NextRun.Time"),
+			},
+			{
+				Name:        "next_run_offset_minutes",
+				Description: "The next run time's offset in minutes.",
+				Type:        proto.ColumnType_DOUBLE,
+				Transform:   transform.FromField("ScheduleProperties.NextRunOffsetMinutes"),
+			},
+			{
+				Name:        "creation_time",
+				Description: "The creation time of the schedule.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("ScheduleProperties.CreationTime.Time"),
+			},
+			{
+				Name:        "last_modified_time",
+				Description: "The last modified time of the schedule.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("ScheduleProperties.LastModifiedTime.Time"),
+			},
+			{
+				Name:        "advanced_schedule",
+				Description: "The advanced schedule, with the week days, month days and monthly occurrences the schedule runs on.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("ScheduleProperties.AdvancedSchedule"),
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+			{
+				Name:        "akas",
+				Description: ColumnDescriptionAkas,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("ID").Transform(idToAkas),
+			},
+
+			// Azure standard columns
+			{
+				Name:        "region",
+				Description: ColumnDescriptionRegion,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Location").Transform(toLower),
+			},
+			{
+				Name:        "resource_group",
+				Description: ColumnDescriptionResourceGroup,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ID").Transform(extractResourceGroupFromID),
+			},
+		}),
+	}
+}
+
+type ScheduleDetails struct {
+	AccountName string
+	Location    *string
+	automation.Schedule
+}
+
+//// LIST FUNCTION ////
+
+func listAutomationSchedules(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	session, err := GetNewSession(ctx, d, "MANAGEMENT")
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_automation_schedule.listAutomationSchedules", "session_error", err)
+		return nil, err
+	}
+
+	var account automation.Account
+	if h.Item != nil {
+		account = h.Item.(automation.Account)
+	} else {
+		return nil, nil
+	}
+	resourceGroupName := strings.Split(*account.ID, "/")[4]
+	accountName := account.Name
+
+	subscriptionID := session.SubscriptionID
+
+	scheduleClient := automation.NewScheduleClientWithBaseURI(session.ResourceManagerEndpoint, subscriptionID)
+	scheduleClient.Authorizer = session.Authorizer
+
+	result, err := scheduleClient.ListByAutomationAccount(ctx, resourceGroupName, *accountName)
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_automation_schedule.listAutomationSchedules", "api_error", err)
+		return nil, err
+	}
+
+	for _, schedule := range result.Values() {
+		d.StreamListItem(ctx, &ScheduleDetails{*accountName, account.Location, schedule})
+		// Check if context has been cancelled or if the limit has been hit (if specified)
+		// if there is a limit, it will return the number of rows required to reach this limit
+		if d.RowsRemaining(ctx) == 0 {
+			return nil, nil
+		}
+	}
+
+	for result.NotDone() {
+		err = result.NextWithContext(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("azure_automation_schedule.listAutomationSchedules", "paginator_error", err)
+			return nil, err
+		}
+
+		for _, schedule := range result.Values() {
+			d.StreamListItem(ctx, &ScheduleDetails{*accountName, account.Location, schedule})
+			// Check if context has been cancelled or if the limit has been hit (if specified)
+			// if there is a limit, it will return the number of rows required to reach this limit
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+	return nil, err
+}
+
+//// HYDRATE FUNCTIONS ////
+
+func getAutomationSchedule(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+
+	accountName := d.EqualsQuals["account_name"].GetStringValue()
+	name := d.EqualsQuals["name"].GetStringValue()
+	resourceGroup := d.EqualsQuals["resource_group"].GetStringValue()
+
+	// Handle empty check
+	if accountName == "" || name == "" || resourceGroup == "" {
+		return nil, nil
+	}
+
+	session, err := GetNewSession(ctx, d, "MANAGEMENT")
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_automation_schedule.getAutomationSchedule", "session_error", err)
+		return nil, err
+	}
+	subscriptionID := session.SubscriptionID
+
+	scheduleClient := automation.NewScheduleClientWithBaseURI(session.ResourceManagerEndpoint, subscriptionID)
+	scheduleClient.Authorizer = session.Authorizer
+
+	op, err := scheduleClient.Get(ctx, resourceGroup, accountName, name)
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_automation_schedule.getAutomationSchedule", "api_error", err)
+		return nil, err
+	}
+
+	// In some cases the API does not return any notFound error
+	// instead it returns empty data
+	if op.ID == nil {
+		return nil, nil
+	}
+
+	// The schedule does not return the location, so it is taken from the automation account
+	accountClient := automation.NewAccountClientWithBaseURI(session.ResourceManagerEndpoint, subscriptionID)
+	accountClient.Authorizer = session.Authorizer
+
+	account, err := accountClient.Get(ctx, resourceGroup, accountName)
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_automation_schedule.getAutomationSchedule", "api_error", err)
+		return nil, err
+	}
+
+	return &ScheduleDetails{accountName, account.Location, op}, nil
+}
@@ -27,6 +27,13 @@ func tableAzureBatchAccount(_ context.Context) *plugin.Table {
 		List: &plugin.ListConfig{
 			Hydrate: listBatchAccounts,
 		},
+		HydrateConfig: []plugin.HydrateConfig{
+			{
+				Func:           listBatchAccountDiagnosticSettings,
+				MaxConcurrency: diagnosticSettingsMaxConcurrency,
+				Tags:           map[string]string{"service": "Microsoft.Insights", "action": "diagnosticSettings/list"},
+			},
+		},
 		Columns: azureColumns([]*plugin.Column{
 			{
 				Name:        "name",
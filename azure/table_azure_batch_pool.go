@@ -0,0 +1,344 @@
+package azure
+
+import (
+	"context"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/profiles/latest/batch/mgmt/batch"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+)
+
+//// TABLE DEFINITION
+
+func tableAzureBatchPool(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "azure_batch_pool",
+		Description: "Azure Batch Pool",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.AllColumns([]string{"account_name", "name", "resource_group"}),
+			Hydrate:    getBatchPool,
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: isNotFoundError([]string{"ResourceNotFound", "ResourceGroupNotFound", "PoolNotFound", "404"}),
+			},
+		},
+		List: &plugin.ListConfig{
+			Hydrate:       listBatchPools,
+			ParentHydrate: listBatchAccounts,
+		},
+		Columns: azureColumns([]*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name of the pool.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "id",
+				Description: "The ID of the pool.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromGo(),
+			},
+			{
+				Name:        "account_name",
+				Description: "The name of the batch account the pool belongs to.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "display_name",
+				Description: "The display name of the pool.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("PoolProperties.DisplayName"),
+			},
+			{
+				Name:        "type",
+				Description: "The type of the resource.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "etag",
+				Description: "The ETag of the resource, used for concurrency statements.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "provisioning_state",
+				Description: "The current state of the pool. Possible values include: 'Succeeded', 'Deleting'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("PoolProperties.ProvisioningState"),
+			},
+			{
+				Name:        "creation_time",
+				Description: "The creation time of the pool.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("PoolProperties.CreationTime").Transform(convertDateToTime),
+			},
+			{
+				Name:        "last_modified",
+				Description: "The last time at which the pool level data, such as the target dedicated nodes or the enable auto scale settings, changed.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("PoolProperties.LastModified").Transform(convertDateToTime),
+			},
+			{
+				Name:        "allocation_state",
+				Description: "Whether the pool is resizing. Possible values include: 'Steady', 'Resizing', 'Stopping'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("PoolProperties.AllocationState"),
+			},
+			{
+				Name:        "allocation_state_transition_time",
+				Description: "The time at which the pool entered its current allocation state.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("PoolProperties.AllocationStateTransitionTime").Transform(convertDateToTime),
+			},
+			{
+				Name:        "vm_size",
+				Description: "The size of the virtual machines in the pool. All VMs in a pool are the same size.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("PoolProperties.VMSize"),
+			},
+			{
+				Name:        "current_dedicated_nodes",
+				Description: "The number of dedicated compute nodes currently in the pool.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("PoolProperties.CurrentDedicatedNodes"),
+			},
+			{
+				Name:        "current_low_priority_nodes",
+				Description: "The number of Spot/low-priority compute nodes currently in the pool.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("PoolProperties.CurrentLowPriorityNodes"),
+			},
+			{
+				Name:        "auto_scale_formula",
+				Description: "The formula for the desired number of compute nodes in the pool, if the pool automatically scales.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("PoolProperties.ScaleSettings.AutoScale.Formula"),
+			},
+			{
+				Name:        "auto_scale_evaluation_interval",
+				Description: "The time interval at which to automatically adjust the pool size according to the autoscale formula.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("PoolProperties.ScaleSettings.AutoScale.EvaluationInterval"),
+			},
+			{
+				Name:        "inter_node_communication",
+				Description: "Whether the pool permits direct communication between nodes. Possible values include: 'Enabled', 'Disabled'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("PoolProperties.InterNodeCommunication"),
+			},
+			{
+				Name:        "max_tasks_per_node",
+				Description: "The number of task slots that can be used to run concurrent tasks on a single compute node in the pool.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("PoolProperties.TaskSlotsPerNode"),
+			},
+			{
+				Name:        "deployment_configuration",
+				Description: "The deployment configuration of the pool, with the image reference and the node agent SKU of the virtual machines.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("PoolProperties.DeploymentConfiguration"),
+			},
+			{
+				Name:        "scale_settings",
+				Description: "The settings which configure the number of nodes in the pool, either fixed or automatically scaled.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("PoolProperties.ScaleSettings"),
+			},
+			{
+				Name:        "auto_scale_run",
+				Description: "The results and errors from the last execution of the autoscale formula.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("PoolProperties.AutoScaleRun"),
+			},
+			{
+				Name:        "task_scheduling_policy",
+				Description: "How tasks are distributed across compute nodes in the pool.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("PoolProperties.TaskSchedulingPolicy"),
+			},
+			{
+				Name:        "start_task",
+				Description: "The task that runs on each compute node as it joins the pool. The values of the environment settings are not returned.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("PoolProperties.StartTask").Transform(maskBatchPoolStartTask),
+			},
+			{
+				Name:        "certificate_references",
+				Description: "The list of certificates to be installed on each compute node in the pool.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("PoolProperties.Certificates"),
+			},
+			{
+				Name:        "application_packages",
+				Description: "The list of application packages to be installed on each compute node in the pool.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("PoolProperties.ApplicationPackages"),
+			},
+			{
+				Name:        "network_configuration",
+				Description: "The network configuration of the pool.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("PoolProperties.NetworkConfiguration"),
+			},
+			{
+				Name:        "identity",
+				Description: "The type of identity used for the Batch Pool.",
+				Type:        proto.ColumnType_JSON,
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+			{
+				Name:        "akas",
+				Description: ColumnDescriptionAkas,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("ID").Transform(idToAkas),
+			},
+
+			// Azure standard columns
+			{
+				Name:        "region",
+				Description: ColumnDescriptionRegion,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Location").Transform(toLower),
+			},
+			{
+				Name:        "resource_group",
+				Description: ColumnDescriptionResourceGroup,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ID").Transform(extractResourceGroupFromID),
+			},
+		}),
+	}
+}
+
+type BatchPoolInfo = struct {
+	batch.Pool
+	AccountName *string
+	Location    *string
+}
+
+//// LIST FUNCTION
+
+func listBatchPools(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	// Get the details of batch account
+	account := h.Item.(batch.Account)
+	resourceGroup := strings.Split(*account.ID, "/")[4]
+
+	session, err := GetNewSession(ctx, d, "MANAGEMENT")
+	if err != nil {
+		return nil, err
+	}
+	subscriptionID := session.SubscriptionID
+
+	client := batch.NewPoolClientWithBaseURI(session.ResourceManagerEndpoint, subscriptionID)
+	client.Authorizer = session.Authorizer
+
+	result, err := client.ListByBatchAccount(ctx, resourceGroup, *account.Name, nil, "", "")
+	if err != nil {
+		plugin.Logger(ctx).Error("listBatchPools", "list", err)
+		return nil, err
+	}
+
+	for _, pool := range result.Values() {
+		d.StreamListItem(ctx, BatchPoolInfo{pool, account.Name, account.Location})
+		// Check if context has been cancelled or if the limit has been hit (if specified)
+		// if there is a limit, it will return the number of rows required to reach this limit
+		if d.RowsRemaining(ctx) == 0 {
+			return nil, nil
+		}
+	}
+
+	for result.NotDone() {
+		err = result.NextWithContext(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("listBatchPools", "list_paging", err)
+			return nil, err
+		}
+		for _, pool := range result.Values() {
+			d.StreamListItem(ctx, BatchPoolInfo{pool, account.Name, account.Location})
+			// Check if context has been cancelled or if the limit has been hit (if specified)
+			// if there is a limit, it will return the number of rows required to reach this limit
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, err
+}
+
+//// HYDRATE FUNCTIONS
+
+func getBatchPool(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("getBatchPool")
+
+	accountName := d.EqualsQuals["account_name"].GetStringValue()
+	name := d.EqualsQuals["name"].GetStringValue()
+	resourceGroup := d.EqualsQuals["resource_group"].GetStringValue()
+
+	// Handle empty check
+	if accountName == "" || name == "" || resourceGroup == "" {
+		return nil, nil
+	}
+
+	session, err := GetNewSession(ctx, d, "MANAGEMENT")
+	if err != nil {
+		return nil, err
+	}
+	subscriptionID := session.SubscriptionID
+
+	client := batch.NewPoolClientWithBaseURI(session.ResourceManagerEndpoint, subscriptionID)
+	client.Authorizer = session.Authorizer
+
+	op, err := client.Get(ctx, resourceGroup, accountName, name)
+	if err != nil {
+		plugin.Logger(ctx).Error("getBatchPool", "get", err)
+		return nil, err
+	}
+
+	// In some cases resource does not give any notFound error
+	// instead of notFound error, it returns empty data
+	if op.ID == nil {
+		return nil, nil
+	}
+
+	// The pool does not return the location, so it is taken from the batch account
+	accountClient := batch.NewAccountClientWithBaseURI(session.ResourceManagerEndpoint, subscriptionID)
+	accountClient.Authorizer = session.Authorizer
+
+	account, err := accountClient.Get(ctx, resourceGroup, accountName)
+	if err != nil {
+		plugin.Logger(ctx).Error("getBatchPool", "get_account", err)
+		return nil, err
+	}
+
+	return BatchPoolInfo{op, account.Name, account.Location}, nil
+}
+
+//// TRANSFORM FUNCTIONS
+
+// Environment settings of the start task often carry secrets, so only their names are returned
+func maskBatchPoolStartTask(ctx context.Context, d *transform.TransformData) (interface{}, error) {
+	startTask, ok := d.Value.(*batch.StartTask)
+	if !ok || startTask == nil {
+		return nil, nil
+	}
+
+	masked := *startTask
+	if startTask.EnvironmentSettings != nil {
+		settings := []batch.EnvironmentSetting{}
+		for _, setting := range *startTask.EnvironmentSettings {
+			settings = append(settings, batch.EnvironmentSetting{Name: setting.Name})
+		}
+		masked.EnvironmentSettings = &settings
+	}
+
+	return masked, nil
+}
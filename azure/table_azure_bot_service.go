@@ -0,0 +1,382 @@
+package azure
+
+import (
+	"context"
+
+	"github.com/Azure/azure-sdk-for-go/profiles/preview/preview/botservice/mgmt/botservice"
+	"github.com/turbot/go-kit/types"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+)
+
+//// TABLE DEFINITION
+
+func tableAzureBotService(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "azure_bot_service",
+		Description: "Azure Bot Service",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.AllColumns([]string{"name", "resource_group"}),
+			Hydrate:    getBotService,
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: isNotFoundError([]string{"ResourceNotFound", "ResourceGroupNotFound", "404"}),
+			},
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listBotServices,
+		},
+		Columns: azureColumns([]*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name of the bot resource.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "id",
+				Description: "The ID of the bot resource.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromGo(),
+			},
+			{
+				Name:        "type",
+				Description: "The type of the resource.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "kind",
+				Description: "The kind of the bot. Possible values include: 'sdk', 'designer', 'bot', 'function', 'azurebot'.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "display_name",
+				Description: "The name of the bot.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties.DisplayName"),
+			},
+			{
+				Name:        "description",
+				Description: "The description of the bot.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties.Description"),
+			},
+			{
+				Name:        "provisioning_state",
+				Description: "The provisioning state of the bot.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties.ProvisioningState"),
+			},
+			{
+				Name:        "icon_url",
+				Description: "The icon URL of the bot.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties.IconURL"),
+			},
+			{
+				Name:        "endpoint",
+				Description: "The bot's messaging endpoint.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties.Endpoint"),
+			},
+			{
+				Name:        "endpoint_version",
+				Description: "The bot's endpoint version.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties.EndpointVersion"),
+			},
+			{
+				Name:        "msapp_id",
+				Description: "The Microsoft App ID for the bot.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties.MsaAppID"),
+			},
+			{
+				Name:        "msa_app_type",
+				Description: "The Microsoft App type for the bot. Possible values include: 'UserAssignedMSI', 'SingleTenant', 'MultiTenant'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties.MsaAppType"),
+			},
+			{
+				Name:        "msa_app_tenant_id",
+				Description: "The Microsoft App tenant ID for the bot.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties.MsaAppTenantID"),
+			},
+			{
+				Name:        "msa_app_msi_resource_id",
+				Description: "The ID of the user assigned managed identity used as the Microsoft App of the bot.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties.MsaAppMSIResourceID"),
+			},
+			{
+				Name:        "sku_name",
+				Description: "The name of the SKU. Possible values include: 'F0', 'S1'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Sku.Name"),
+			},
+			{
+				Name:        "sku_tier",
+				Description: "The tier of the SKU. Possible values include: 'Free', 'Standard'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Sku.Tier"),
+			},
+			{
+				Name:        "manifest_url",
+				Description: "The bot's manifest URL.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties.ManifestURL"),
+			},
+			{
+				Name:        "developer_app_insight_key",
+				Description: "The Application Insights instrumentation key of the bot.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties.DeveloperAppInsightKey"),
+			},
+			{
+				Name:        "developer_app_insights_api_key",
+				Description: "The Application Insights API key of the bot. The key itself is never returned; the value is masked when a key is set.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties.DeveloperAppInsightsAPIKey").Transform(maskBotServiceSecret),
+			},
+			{
+				Name:        "is_developer_app_insights_api_key_set",
+				Description: "Indicates whether the Application Insights API key of the bot is set.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("Properties.IsDeveloperAppInsightsAPIKeySet"),
+			},
+			{
+				Name:        "developer_app_insights_application_id",
+				Description: "The Application Insights application ID of the bot.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties.DeveloperAppInsightsApplicationID"),
+			},
+			{
+				Name:        "is_cmek_enabled",
+				Description: "Indicates whether the bot is encrypted with a customer-managed key.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("Properties.IsCmekEnabled"),
+			},
+			{
+				Name:        "cmek_key_vault_url",
+				Description: "The Key Vault key URL used to encrypt the bot.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties.CmekKeyVaultURL"),
+			},
+			{
+				Name:        "cmek_encryption_status",
+				Description: "The status of the customer-managed key encryption.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties.CmekEncryptionStatus"),
+			},
+			{
+				Name:        "public_network_access",
+				Description: "Whether the bot is in an isolated network. Possible values include: 'Enabled', 'Disabled'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties.PublicNetworkAccess"),
+			},
+			{
+				Name:        "disable_local_auth",
+				Description: "Indicates whether local authentication is disabled for the bot.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("Properties.DisableLocalAuth"),
+			},
+			{
+				Name:        "is_streaming_supported",
+				Description: "Indicates whether the bot is streaming supported.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("Properties.IsStreamingSupported"),
+			},
+			{
+				Name:        "open_with_hints",
+				Description: "The hint to the browser, such as a protocol handler, on how to open the bot for authoring.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties.OpenWithHint"),
+			},
+			{
+				Name:        "schema_transformation_version",
+				Description: "The channel schema transformation version for the bot.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties.SchemaTransformationVersion"),
+			},
+			{
+				Name:        "storage_resource_id",
+				Description: "The storage resource ID for the bot.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties.StorageResourceID"),
+			},
+			{
+				Name:        "etag",
+				Description: "An unique read-only string that changes whenever the resource is updated.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Etag"),
+			},
+			{
+				Name:        "all_settings",
+				Description: "Contains the bot's settings.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Properties.AllSettings"),
+			},
+			{
+				Name:        "parameters",
+				Description: "Contains the bot's parameters.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Properties.Parameters"),
+			},
+			{
+				Name:        "configured_channels",
+				Description: "The channels configured for the bot.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Properties.ConfiguredChannels"),
+			},
+			{
+				Name:        "enabled_channels",
+				Description: "The channels enabled for the bot.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Properties.EnabledChannels"),
+			},
+			{
+				Name:        "luis_app_ids",
+				Description: "The LUIS app IDs associated with the bot.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Properties.LuisAppIds"),
+			},
+			{
+				Name:        "private_endpoint_connections",
+				Description: "The private endpoint connections of the bot.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Properties.PrivateEndpointConnections"),
+			},
+			{
+				Name:        "zones",
+				Description: "The availability zones of the bot.",
+				Type:        proto.ColumnType_JSON,
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+			{
+				Name:        "tags",
+				Description: ColumnDescriptionTags,
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "akas",
+				Description: ColumnDescriptionAkas,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("ID").Transform(idToAkas),
+			},
+
+			// Azure standard columns
+			{
+				Name:        "region",
+				Description: ColumnDescriptionRegion,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Location").Transform(toLower),
+			},
+			{
+				Name:        "resource_group",
+				Description: ColumnDescriptionResourceGroup,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ID").Transform(extractResourceGroupFromID),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listBotServices(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	session, err := GetNewSession(ctx, d, "MANAGEMENT")
+	if err != nil {
+		return nil, err
+	}
+	subscriptionID := session.SubscriptionID
+
+	client := botservice.NewBotsClientWithBaseURI(session.ResourceManagerEndpoint, subscriptionID)
+	client.Authorizer = session.Authorizer
+
+	result, err := client.List(ctx)
+	if err != nil {
+		plugin.Logger(ctx).Error("listBotServices", "list", err)
+		return nil, err
+	}
+
+	for _, bot := range result.Values() {
+		d.StreamListItem(ctx, bot)
+		// Check if context has been cancelled or if the limit has been hit (if specified)
+		// if there is a limit, it will return the number of rows required to reach this limit
+		if d.RowsRemaining(ctx) == 0 {
+			return nil, nil
+		}
+	}
+
+	for result.NotDone() {
+		err = result.NextWithContext(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("listBotServices", "list_paging", err)
+			return nil, err
+		}
+		for _, bot := range result.Values() {
+			d.StreamListItem(ctx, bot)
+			// Check if context has been cancelled or if the limit has been hit (if specified)
+			// if there is a limit, it will return the number of rows required to reach this limit
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, err
+}
+
+//// HYDRATE FUNCTIONS
+
+func getBotService(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("getBotService")
+
+	name := d.EqualsQuals["name"].GetStringValue()
+	resourceGroup := d.EqualsQuals["resource_group"].GetStringValue()
+
+	// Handle empty name or resourceGroup
+	if name == "" || resourceGroup == "" {
+		return nil, nil
+	}
+
+	session, err := GetNewSession(ctx, d, "MANAGEMENT")
+	if err != nil {
+		return nil, err
+	}
+	subscriptionID := session.SubscriptionID
+
+	client := botservice.NewBotsClientWithBaseURI(session.ResourceManagerEndpoint, subscriptionID)
+	client.Authorizer = session.Authorizer
+
+	op, err := client.Get(ctx, resourceGroup, name)
+	if err != nil {
+		plugin.Logger(ctx).Error("getBotService", "get", err)
+		return nil, err
+	}
+
+	// In some cases resource does not give any notFound error
+	// instead of notFound error, it returns empty data
+	if op.ID != nil {
+		return op, nil
+	}
+
+	return nil, nil
+}
+
+//// TRANSFORM FUNCTIONS
+
+func maskBotServiceSecret(ctx context.Context, d *transform.TransformData) (interface{}, error) {
+	if types.SafeString(d.Value) == "" {
+		return nil, nil
+	}
+	return "********", nil
+}
@@ -5,6 +5,7 @@ import (
 
 	"github.com/Azure/azure-sdk-for-go/profiles/latest/cognitiveservices/mgmt/cognitiveservices"
 	"github.com/Azure/azure-sdk-for-go/profiles/preview/preview/monitor/mgmt/insights"
+	"github.com/turbot/go-kit/types"
 
 	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
 	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
@@ -28,6 +29,13 @@ func tableAzureCognitiveAccount(_ context.Context) *plugin.Table {
 		List: &plugin.ListConfig{
 			Hydrate: listCognitiveAccounts,
 		},
+		HydrateConfig: []plugin.HydrateConfig{
+			{
+				Func:           listCognitiveAccountDiagnosticSettings,
+				MaxConcurrency: diagnosticSettingsMaxConcurrency,
+				Tags:           map[string]string{"service": "Microsoft.Insights", "action": "diagnosticSettings/list"},
+			},
+		},
 		Columns: azureColumns([]*plugin.Column{
 			{
 				Name:        "name",
@@ -62,6 +70,12 @@ func tableAzureCognitiveAccount(_ context.Context) *plugin.Table {
 				Type:        proto.ColumnType_STRING,
 				Transform:   transform.FromField("Properties.CustomSubDomainName"),
 			},
+			{
+				Name:        "is_custom_domain",
+				Description: "Indicates whether the account uses a custom subdomain name for its endpoint.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("Properties.CustomSubDomainName").Transform(isCognitiveAccountCustomDomain),
+			},
 			{
 				Name:        "date_created",
 				Description: "The date of cognitive services account creation.",
@@ -137,6 +151,24 @@ func tableAzureCognitiveAccount(_ context.Context) *plugin.Table {
 				Type:        proto.ColumnType_JSON,
 				Transform:   transform.FromField("Properties.CallRateLimit"),
 			},
+			{
+				Name:        "sku_name",
+				Description: "The name of the SKU, e.g. F0 or S0.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Sku.Name"),
+			},
+			{
+				Name:        "sku_tier",
+				Description: "The tier of the SKU. Possible values include: 'Free', 'Basic', 'Standard', 'Premium', 'Enterprise'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Sku.Tier"),
+			},
+			{
+				Name:        "sku_capacity",
+				Description: "The capacity of the SKU, if the SKU supports scale out/in.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("Sku.Capacity"),
+			},
 			{
 				Name:        "capabilities",
 				Description: "The capabilities of the cognitive services account. Each item indicates the capability of a specific feature. The values are read-only and for reference only.",
@@ -150,6 +182,13 @@ func tableAzureCognitiveAccount(_ context.Context) *plugin.Table {
 				Hydrate:     listCognitiveAccountDiagnosticSettings,
 				Transform:   transform.FromValue(),
 			},
+			{
+				Name:        "diagnostic_log_categories",
+				Description: "The distinct diagnostic log categories enabled across all diagnostic settings of the cognitive services account.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     listCognitiveAccountDiagnosticSettings,
+				Transform:   transform.FromValue().Transform(extractCognitiveAccountDiagnosticLogCategories),
+			},
 			{
 				Name:        "encryption",
 				Description: "The encryption properties for the resource.",
@@ -374,6 +413,40 @@ func listCognitiveAccountDiagnosticSettings(ctx context.Context, d *plugin.Query
 	return diagnosticSettings, nil
 }
 
+func isCognitiveAccountCustomDomain(ctx context.Context, d *transform.TransformData) (interface{}, error) {
+	if d.Value == nil {
+		return false, nil
+	}
+	return types.SafeString(d.Value) != "", nil
+}
+
+func extractCognitiveAccountDiagnosticLogCategories(ctx context.Context, d *transform.TransformData) (interface{}, error) {
+	diagnosticSettings, ok := d.Value.([]map[string]interface{})
+	if !ok {
+		return nil, nil
+	}
+
+	categories := []string{}
+	seen := map[string]bool{}
+	for _, setting := range diagnosticSettings {
+		properties, ok := setting["properties"].(*insights.DiagnosticSettings)
+		if !ok || properties.Logs == nil {
+			continue
+		}
+		for _, log := range *properties.Logs {
+			if log.Category == nil || log.Enabled == nil || !*log.Enabled {
+				continue
+			}
+			if !seen[*log.Category] {
+				seen[*log.Category] = true
+				categories = append(categories, *log.Category)
+			}
+		}
+	}
+
+	return categories, nil
+}
+
 // If we return the API response directly, the output will not provide all the properties of PrivateEndpointConnections
 func extractAccountPrivateEndpointConnections(ctx context.Context, d *transform.TransformData) (interface{}, error) {
 	account := d.HydrateItem.(cognitiveservices.Account)
@@ -0,0 +1,238 @@
+package azure
+
+import (
+	"context"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/profiles/latest/cognitiveservices/mgmt/cognitiveservices"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+type CognitiveAccountDeploymentInfo = struct {
+	cognitiveservices.Deployment
+	AccountName *string
+	Location    *string
+}
+
+//// TABLE DEFINITION
+
+func tableAzureCognitiveAccountDeployment(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "azure_cognitive_account_deployment",
+		Description: "Azure Cognitive Account Deployment",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.AllColumns([]string{"account_name", "name", "resource_group"}),
+			Hydrate:    getCognitiveAccountDeployment,
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: isNotFoundError([]string{"ResourceNotFound", "ResourceGroupNotFound", "404"}),
+			},
+		},
+		List: &plugin.ListConfig{
+			ParentHydrate: listCognitiveAccounts,
+			Hydrate:       listCognitiveAccountDeployments,
+		},
+		Columns: azureColumns([]*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name of the deployment.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "id",
+				Description: "The ID of the deployment.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromGo(),
+			},
+			{
+				Name:        "type",
+				Description: "The type of the resource.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "account_name",
+				Description: "The name of the cognitive account the deployment belongs to.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "provisioning_state",
+				Description: "The provisioning state of the deployment. Possible values include: 'Accepted', 'Creating', 'Deleting', 'Moving', 'Failed', 'Succeeded'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties.ProvisioningState"),
+			},
+			{
+				Name:        "model_format",
+				Description: "The format of the deployed model, e.g. 'OpenAI'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties.Model.Format"),
+			},
+			{
+				Name:        "model_name",
+				Description: "The name of the deployed model.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties.Model.Name"),
+			},
+			{
+				Name:        "model_version",
+				Description: "The version of the deployed model.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties.Model.Version"),
+			},
+			{
+				Name:        "scale_type",
+				Description: "The scale type of the deployment. Possible values include: 'Manual'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties.ScaleSettings.ScaleType"),
+			},
+			{
+				Name:        "capacity_units",
+				Description: "The capacity units of the deployment.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("Properties.ScaleSettings.Capacity"),
+			},
+			{
+				Name:        "active_capacity_units",
+				Description: "The active capacity units of the deployment. This value might be different from capacity_units if the capacity was recently updated.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("Properties.ScaleSettings.ActiveCapacity"),
+			},
+			{
+				Name:        "etag",
+				Description: "An unique read-only string that changes whenever the resource is updated.",
+				Type:        proto.ColumnType_STRING,
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+			{
+				Name:        "akas",
+				Description: ColumnDescriptionAkas,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("ID").Transform(idToAkas),
+			},
+
+			// Azure standard columns
+			{
+				Name:        "region",
+				Description: ColumnDescriptionRegion,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Location").Transform(toLower),
+			},
+			{
+				Name:        "resource_group",
+				Description: ColumnDescriptionResourceGroup,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ID").Transform(extractResourceGroupFromID),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listCognitiveAccountDeployments(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	account := h.Item.(cognitiveservices.Account)
+
+	// Model deployments only exist in Azure OpenAI accounts
+	if account.Kind == nil || *account.Kind != "OpenAI" {
+		return nil, nil
+	}
+	resourceGroup := strings.Split(*account.ID, "/")[4]
+
+	session, err := GetNewSession(ctx, d, "MANAGEMENT")
+	if err != nil {
+		return nil, err
+	}
+	subscriptionID := session.SubscriptionID
+
+	client := cognitiveservices.NewDeploymentsClientWithBaseURI(session.ResourceManagerEndpoint, subscriptionID)
+	client.Authorizer = session.Authorizer
+
+	result, err := client.List(ctx, resourceGroup, *account.Name)
+	if err != nil {
+		plugin.Logger(ctx).Error("listCognitiveAccountDeployments", "list", err)
+		return nil, err
+	}
+
+	for _, deployment := range result.Values() {
+		d.StreamListItem(ctx, CognitiveAccountDeploymentInfo{deployment, account.Name, account.Location})
+		// Check if context has been cancelled or if the limit has been hit (if specified)
+		// if there is a limit, it will return the number of rows required to reach this limit
+		if d.RowsRemaining(ctx) == 0 {
+			return nil, nil
+		}
+	}
+
+	for result.NotDone() {
+		err = result.NextWithContext(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("listCognitiveAccountDeployments", "list_paging", err)
+			return nil, err
+		}
+		for _, deployment := range result.Values() {
+			d.StreamListItem(ctx, CognitiveAccountDeploymentInfo{deployment, account.Name, account.Location})
+			// Check if context has been cancelled or if the limit has been hit (if specified)
+			// if there is a limit, it will return the number of rows required to reach this limit
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getCognitiveAccountDeployment(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("getCognitiveAccountDeployment")
+
+	accountName := d.EqualsQuals["account_name"].GetStringValue()
+	name := d.EqualsQuals["name"].GetStringValue()
+	resourceGroup := d.EqualsQuals["resource_group"].GetStringValue()
+
+	// Handle empty account_name, name or resourceGroup
+	if accountName == "" || name == "" || resourceGroup == "" {
+		return nil, nil
+	}
+
+	session, err := GetNewSession(ctx, d, "MANAGEMENT")
+	if err != nil {
+		return nil, err
+	}
+	subscriptionID := session.SubscriptionID
+
+	// The deployment does not return the location, so it is taken from the account
+	accountsClient := cognitiveservices.NewAccountsClientWithBaseURI(session.ResourceManagerEndpoint, subscriptionID)
+	accountsClient.Authorizer = session.Authorizer
+
+	account, err := accountsClient.Get(ctx, resourceGroup, accountName)
+	if err != nil {
+		plugin.Logger(ctx).Error("getCognitiveAccountDeployment", "get_account", err)
+		return nil, err
+	}
+
+	client := cognitiveservices.NewDeploymentsClientWithBaseURI(session.ResourceManagerEndpoint, subscriptionID)
+	client.Authorizer = session.Authorizer
+
+	deployment, err := client.Get(ctx, resourceGroup, accountName, name)
+	if err != nil {
+		plugin.Logger(ctx).Error("getCognitiveAccountDeployment", "get", err)
+		return nil, err
+	}
+
+	// In some cases resource does not give any notFound error
+	// instead of notFound error, it returns empty data
+	if deployment.ID != nil {
+		return CognitiveAccountDeploymentInfo{deployment, account.Name, account.Location}, nil
+	}
+
+	return nil, nil
+}
@@ -0,0 +1,246 @@
+package azure
+
+import (
+	"context"
+
+	"github.com/Azure/azure-sdk-for-go/profiles/latest/communication/mgmt/communication"
+	"github.com/Azure/azure-sdk-for-go/profiles/latest/resources/mgmt/resources"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+)
+
+// The Communication API version of the Azure SDK used by the plugin does not
+// return the linked domains and identity of a service, so they are read as a
+// generic resource
+const communicationServiceAPIVersion = "2023-03-31"
+
+//// TABLE DEFINITION
+
+func tableAzureCommunicationService(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "azure_communication_service",
+		Description: "Azure Communication Service",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.AllColumns([]string{"name", "resource_group"}),
+			Hydrate:    getCommunicationService,
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: isNotFoundError([]string{"ResourceNotFound", "ResourceGroupNotFound", "404"}),
+			},
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listCommunicationServices,
+		},
+		Columns: azureColumns([]*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name of the communication service.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "id",
+				Description: "The ID of the communication service.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromGo(),
+			},
+			{
+				Name:        "type",
+				Description: "The type of the resource.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "provisioning_state",
+				Description: "The provisioning state of the communication service.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ServiceProperties.ProvisioningState"),
+			},
+			{
+				Name:        "host_name",
+				Description: "The FQDN of the communication service instance.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ServiceProperties.HostName"),
+			},
+			{
+				Name:        "data_location",
+				Description: "The location where the communication service stores its data at rest.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ServiceProperties.DataLocation"),
+			},
+			{
+				Name:        "notification_hub_id",
+				Description: "The resource ID of an Azure Notification Hub linked to this resource.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ServiceProperties.NotificationHubID"),
+			},
+			{
+				Name:        "version",
+				Description: "The version of the communication service resource.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ServiceProperties.Version"),
+			},
+			{
+				Name:        "immutable_resource_id",
+				Description: "The immutable resource ID of the communication service.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ServiceProperties.ImmutableResourceID"),
+			},
+			{
+				Name:        "linked_domains",
+				Description: "The list of email domain resource IDs linked to the communication service.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getCommunicationServiceProperties,
+				Transform:   transform.FromField("Properties").TransformP(extractGenericResourceProperty, "linkedDomains"),
+			},
+			{
+				Name:        "identity",
+				Description: "The managed service identities assigned to the communication service.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getCommunicationServiceProperties,
+				Transform:   transform.FromField("Identity"),
+			},
+			{
+				Name:        "system_data",
+				Description: "Metadata pertaining to creation and last modification of the resource.",
+				Type:        proto.ColumnType_JSON,
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+			{
+				Name:        "tags",
+				Description: ColumnDescriptionTags,
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "akas",
+				Description: ColumnDescriptionAkas,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("ID").Transform(idToAkas),
+			},
+
+			// Azure standard columns
+			{
+				Name:        "region",
+				Description: ColumnDescriptionRegion,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Location").Transform(toLower),
+			},
+			{
+				Name:        "resource_group",
+				Description: ColumnDescriptionResourceGroup,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ID").Transform(extractResourceGroupFromID),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listCommunicationServices(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	session, err := GetNewSession(ctx, d, "MANAGEMENT")
+	if err != nil {
+		return nil, err
+	}
+	subscriptionID := session.SubscriptionID
+
+	client := communication.NewServiceClientWithBaseURI(session.ResourceManagerEndpoint, subscriptionID)
+	client.Authorizer = session.Authorizer
+
+	result, err := client.ListBySubscription(ctx)
+	if err != nil {
+		plugin.Logger(ctx).Error("listCommunicationServices", "list", err)
+		return nil, err
+	}
+
+	for _, service := range result.Values() {
+		d.StreamListItem(ctx, service)
+		// Check if context has been cancelled or if the limit has been hit (if specified)
+		// if there is a limit, it will return the number of rows required to reach this limit
+		if d.RowsRemaining(ctx) == 0 {
+			return nil, nil
+		}
+	}
+
+	for result.NotDone() {
+		err = result.NextWithContext(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("listCommunicationServices", "list_paging", err)
+			return nil, err
+		}
+		for _, service := range result.Values() {
+			d.StreamListItem(ctx, service)
+			// Check if context has been cancelled or if the limit has been hit (if specified)
+			// if there is a limit, it will return the number of rows required to reach this limit
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, err
+}
+
+//// HYDRATE FUNCTIONS
+
+func getCommunicationService(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("getCommunicationService")
+
+	name := d.EqualsQuals["name"].GetStringValue()
+	resourceGroup := d.EqualsQuals["resource_group"].GetStringValue()
+
+	// Handle empty name or resourceGroup
+	if name == "" || resourceGroup == "" {
+		return nil, nil
+	}
+
+	session, err := GetNewSession(ctx, d, "MANAGEMENT")
+	if err != nil {
+		return nil, err
+	}
+	subscriptionID := session.SubscriptionID
+
+	client := communication.NewServiceClientWithBaseURI(session.ResourceManagerEndpoint, subscriptionID)
+	client.Authorizer = session.Authorizer
+
+	op, err := client.Get(ctx, resourceGroup, name)
+	if err != nil {
+		plugin.Logger(ctx).Error("getCommunicationService", "get", err)
+		return nil, err
+	}
+
+	// In some cases resource does not give any notFound error
+	// instead of notFound error, it returns empty data
+	if op.ID != nil {
+		return op, nil
+	}
+
+	return nil, nil
+}
+
+func getCommunicationServiceProperties(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	service := h.Item.(communication.ServiceResource)
+
+	session, err := GetNewSession(ctx, d, "MANAGEMENT")
+	if err != nil {
+		return nil, err
+	}
+	subscriptionID := session.SubscriptionID
+
+	client := resources.NewClientWithBaseURI(session.ResourceManagerEndpoint, subscriptionID)
+	client.Authorizer = session.Authorizer
+
+	op, err := client.GetByID(ctx, *service.ID, communicationServiceAPIVersion)
+	if err != nil {
+		plugin.Logger(ctx).Error("getCommunicationServiceProperties", "get", err)
+		return nil, err
+	}
+
+	return op, nil
+}
@@ -0,0 +1,39 @@
+package azure
+
+import (
+	"context"
+
+	"github.com/Azure/azure-sdk-for-go/profiles/latest/compute/mgmt/compute"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAzureComputeDiskMetricWriteBytesDaily(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "azure_compute_disk_metric_write_bytes_daily",
+		Description: "Azure Compute Disk Metrics - Write Bytes (Daily)",
+		List: &plugin.ListConfig{
+			ParentHydrate: listAzureComputeDisks,
+			Hydrate:       listComputeDiskMetricWriteBytesDaily,
+		},
+		Columns: monitoringMetricColumns([]*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name of the disk.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("DimensionValue").Transform(lastPathElement),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listComputeDiskMetricWriteBytesDaily(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	diskInfo := h.Item.(compute.Disk)
+
+	return listAzureMonitorMetricStatistics(ctx, d, "DAILY", "Microsoft.Compute/disks", "Composite Disk Write Bytes/sec", *diskInfo.ID)
+}
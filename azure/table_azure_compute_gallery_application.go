@@ -0,0 +1,216 @@
+package azure
+
+import (
+	"context"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/profiles/latest/compute/mgmt/compute"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+)
+
+type ComputeGalleryApplicationInfo = struct {
+	compute.GalleryApplication
+	GalleryName *string
+}
+
+//// TABLE DEFINITION
+
+func tableAzureComputeGalleryApplication(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "azure_compute_gallery_application",
+		Description: "Azure Compute Gallery Application",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.AllColumns([]string{"gallery_name", "name", "resource_group"}),
+			Hydrate:    getComputeGalleryApplication,
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: isNotFoundError([]string{"ResourceNotFound", "ResourceGroupNotFound", "404"}),
+			},
+		},
+		List: &plugin.ListConfig{
+			ParentHydrate: listComputeGalleries,
+			Hydrate:       listComputeGalleryApplications,
+		},
+		Columns: azureColumns([]*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name of the gallery application definition.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "id",
+				Description: "The unique ID of the gallery application definition.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromGo(),
+			},
+			{
+				Name:        "type",
+				Description: "The type of the resource.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "gallery_name",
+				Description: "The name of the gallery containing the application definition.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "os_type",
+				Description: "The type of the OS that the application is built for. Possible values include: 'Windows', 'Linux'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("GalleryApplicationProperties.SupportedOSType"),
+			},
+			{
+				Name:        "description",
+				Description: "The description of the gallery application definition.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("GalleryApplicationProperties.Description"),
+			},
+			{
+				Name:        "eula",
+				Description: "The Eula agreement for the gallery application definition.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("GalleryApplicationProperties.Eula"),
+			},
+			{
+				Name:        "privacy_statement_uri",
+				Description: "The privacy statement uri.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("GalleryApplicationProperties.PrivacyStatementURI"),
+			},
+			{
+				Name:        "release_note_uri",
+				Description: "The release note uri.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("GalleryApplicationProperties.ReleaseNoteURI"),
+			},
+			{
+				Name:        "end_of_life_date",
+				Description: "The end of life date of the gallery application definition.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("GalleryApplicationProperties.EndOfLifeDate").Transform(convertDateToTime),
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+			{
+				Name:        "tags",
+				Description: ColumnDescriptionTags,
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "akas",
+				Description: ColumnDescriptionAkas,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("ID").Transform(idToAkas),
+			},
+
+			// Azure standard columns
+			{
+				Name:        "region",
+				Description: ColumnDescriptionRegion,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Location").Transform(toLower),
+			},
+			{
+				Name:        "resource_group",
+				Description: ColumnDescriptionResourceGroup,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ID").Transform(extractResourceGroupFromID),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listComputeGalleryApplications(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	gallery := h.Item.(compute.Gallery)
+	resourceGroup := strings.Split(*gallery.ID, "/")[4]
+
+	session, err := GetNewSession(ctx, d, "MANAGEMENT")
+	if err != nil {
+		return nil, err
+	}
+	subscriptionID := session.SubscriptionID
+
+	client := compute.NewGalleryApplicationsClientWithBaseURI(session.ResourceManagerEndpoint, subscriptionID)
+	client.Authorizer = session.Authorizer
+
+	result, err := client.ListByGallery(ctx, resourceGroup, *gallery.Name)
+	if err != nil {
+		plugin.Logger(ctx).Error("listComputeGalleryApplications", "list", err)
+		return nil, err
+	}
+
+	for _, application := range result.Values() {
+		d.StreamListItem(ctx, ComputeGalleryApplicationInfo{application, gallery.Name})
+		// Check if context has been cancelled or if the limit has been hit (if specified)
+		// if there is a limit, it will return the number of rows required to reach this limit
+		if d.RowsRemaining(ctx) == 0 {
+			return nil, nil
+		}
+	}
+
+	for result.NotDone() {
+		err = result.NextWithContext(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("listComputeGalleryApplications", "list_paging", err)
+			return nil, err
+		}
+		for _, application := range result.Values() {
+			d.StreamListItem(ctx, ComputeGalleryApplicationInfo{application, gallery.Name})
+			// Check if context has been cancelled or if the limit has been hit (if specified)
+			// if there is a limit, it will return the number of rows required to reach this limit
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, err
+}
+
+//// HYDRATE FUNCTIONS
+
+func getComputeGalleryApplication(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("getComputeGalleryApplication")
+
+	galleryName := d.EqualsQuals["gallery_name"].GetStringValue()
+	name := d.EqualsQuals["name"].GetStringValue()
+	resourceGroup := d.EqualsQuals["resource_group"].GetStringValue()
+
+	// Handle empty galleryName, name or resourceGroup
+	if galleryName == "" || name == "" || resourceGroup == "" {
+		return nil, nil
+	}
+
+	session, err := GetNewSession(ctx, d, "MANAGEMENT")
+	if err != nil {
+		return nil, err
+	}
+	subscriptionID := session.SubscriptionID
+
+	client := compute.NewGalleryApplicationsClientWithBaseURI(session.ResourceManagerEndpoint, subscriptionID)
+	client.Authorizer = session.Authorizer
+
+	op, err := client.Get(ctx, resourceGroup, galleryName, name)
+	if err != nil {
+		plugin.Logger(ctx).Error("getComputeGalleryApplication", "get", err)
+		return nil, err
+	}
+
+	// In some cases resource does not give any notFound error
+	// instead of notFound error, it returns empty data
+	if op.ID != nil {
+		return ComputeGalleryApplicationInfo{op, &galleryName}, nil
+	}
+
+	return nil, nil
+}
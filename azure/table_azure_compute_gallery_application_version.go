@@ -0,0 +1,337 @@
+package azure
+
+import (
+	"context"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/profiles/latest/compute/mgmt/compute"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+)
+
+type ComputeGalleryApplicationVersionInfo = struct {
+	compute.GalleryApplicationVersion
+	GalleryName            *string
+	GalleryApplicationName *string
+}
+
+//// TABLE DEFINITION
+
+func tableAzureComputeGalleryApplicationVersion(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "azure_compute_gallery_application_version",
+		Description: "Azure Compute Gallery Application Version",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.AllColumns([]string{"gallery_name", "gallery_application_name", "name", "resource_group"}),
+			Hydrate:    getComputeGalleryApplicationVersion,
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: isNotFoundError([]string{"ResourceNotFound", "ResourceGroupNotFound", "404"}),
+			},
+		},
+		List: &plugin.ListConfig{
+			ParentHydrate: listComputeGalleries,
+			Hydrate:       listComputeGalleryApplicationVersions,
+		},
+		Columns: azureColumns([]*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name of the gallery application version.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "id",
+				Description: "The unique ID of the gallery application version.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromGo(),
+			},
+			{
+				Name:        "type",
+				Description: "The type of the resource.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "gallery_name",
+				Description: "The name of the gallery containing the application version.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "gallery_application_name",
+				Description: "The name of the gallery application definition containing the version.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "provisioning_state",
+				Description: "The provisioning state of the application version. Possible values include: 'Creating', 'Updating', 'Failed', 'Succeeded', 'Deleting', 'Migrating'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("GalleryApplicationVersionProperties.ProvisioningState"),
+			},
+			{
+				Name:        "published_date",
+				Description: "The timestamp for when the application version is published.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("GalleryApplicationVersionProperties.PublishingProfile.PublishedDate").Transform(convertDateToTime),
+			},
+			{
+				Name:        "end_of_life_date",
+				Description: "The end of life date of the application version.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("GalleryApplicationVersionProperties.PublishingProfile.EndOfLifeDate").Transform(convertDateToTime),
+			},
+			{
+				Name:        "exclude_from_latest",
+				Description: "If set to true, virtual machines deployed from the latest version of the application definition won't use this version.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("GalleryApplicationVersionProperties.PublishingProfile.ExcludeFromLatest"),
+			},
+			{
+				Name:        "replica_count",
+				Description: "The number of replicas of the application version to be created per region.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("GalleryApplicationVersionProperties.PublishingProfile.ReplicaCount"),
+			},
+			{
+				Name:        "storage_account_type",
+				Description: "The storage account type used to store the application version. Possible values include: 'Standard_LRS', 'Standard_ZRS', 'Premium_LRS'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("GalleryApplicationVersionProperties.PublishingProfile.StorageAccountType"),
+			},
+			{
+				Name:        "replication_mode",
+				Description: "The mode used for replication. Possible values include: 'Full', 'Shallow'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("GalleryApplicationVersionProperties.PublishingProfile.ReplicationMode"),
+			},
+			{
+				Name:        "enable_health_check",
+				Description: "Whether or not the application reports health.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("GalleryApplicationVersionProperties.PublishingProfile.EnableHealthCheck"),
+			},
+			{
+				Name:        "source",
+				Description: "The source media and default configuration links of the application version.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("GalleryApplicationVersionProperties.PublishingProfile.Source"),
+			},
+			{
+				Name:        "manage_actions",
+				Description: "The install, remove and update commands of the application version.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("GalleryApplicationVersionProperties.PublishingProfile.ManageActions"),
+			},
+			{
+				Name:        "target_regions",
+				Description: "The target regions where the application version is replicated to.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("GalleryApplicationVersionProperties.PublishingProfile.TargetRegions"),
+			},
+			{
+				Name:        "publishing_profile",
+				Description: "The publishing profile of the application version.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("GalleryApplicationVersionProperties.PublishingProfile"),
+			},
+			{
+				Name:        "replication_status",
+				Description: "The aggregated and per-region replication status of the application version.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getComputeGalleryApplicationVersionReplicationStatus,
+				Transform:   transform.FromValue(),
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+			{
+				Name:        "tags",
+				Description: ColumnDescriptionTags,
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "akas",
+				Description: ColumnDescriptionAkas,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("ID").Transform(idToAkas),
+			},
+
+			// Azure standard columns
+			{
+				Name:        "region",
+				Description: ColumnDescriptionRegion,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Location").Transform(toLower),
+			},
+			{
+				Name:        "resource_group",
+				Description: ColumnDescriptionResourceGroup,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ID").Transform(extractResourceGroupFromID),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listComputeGalleryApplicationVersions(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	gallery := h.Item.(compute.Gallery)
+	resourceGroup := strings.Split(*gallery.ID, "/")[4]
+
+	session, err := GetNewSession(ctx, d, "MANAGEMENT")
+	if err != nil {
+		return nil, err
+	}
+	subscriptionID := session.SubscriptionID
+
+	applicationClient := compute.NewGalleryApplicationsClientWithBaseURI(session.ResourceManagerEndpoint, subscriptionID)
+	applicationClient.Authorizer = session.Authorizer
+
+	client := compute.NewGalleryApplicationVersionsClientWithBaseURI(session.ResourceManagerEndpoint, subscriptionID)
+	client.Authorizer = session.Authorizer
+
+	applications, err := applicationClient.ListByGalleryComplete(ctx, resourceGroup, *gallery.Name)
+	if err != nil {
+		plugin.Logger(ctx).Error("listComputeGalleryApplicationVersions", "list_applications", err)
+		return nil, err
+	}
+
+	for applications.NotDone() {
+		application := applications.Value()
+
+		result, err := client.ListByGalleryApplication(ctx, resourceGroup, *gallery.Name, *application.Name)
+		if err != nil {
+			plugin.Logger(ctx).Error("listComputeGalleryApplicationVersions", "list", err)
+			return nil, err
+		}
+
+		for _, version := range result.Values() {
+			d.StreamListItem(ctx, ComputeGalleryApplicationVersionInfo{version, gallery.Name, application.Name})
+			// Check if context has been cancelled or if the limit has been hit (if specified)
+			// if there is a limit, it will return the number of rows required to reach this limit
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+
+		for result.NotDone() {
+			err = result.NextWithContext(ctx)
+			if err != nil {
+				plugin.Logger(ctx).Error("listComputeGalleryApplicationVersions", "list_paging", err)
+				return nil, err
+			}
+			for _, version := range result.Values() {
+				d.StreamListItem(ctx, ComputeGalleryApplicationVersionInfo{version, gallery.Name, application.Name})
+				// Check if context has been cancelled or if the limit has been hit (if specified)
+				// if there is a limit, it will return the number of rows required to reach this limit
+				if d.RowsRemaining(ctx) == 0 {
+					return nil, nil
+				}
+			}
+		}
+
+		if err = applications.NextWithContext(ctx); err != nil {
+			plugin.Logger(ctx).Error("listComputeGalleryApplicationVersions", "list_applications_paging", err)
+			return nil, err
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getComputeGalleryApplicationVersion(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("getComputeGalleryApplicationVersion")
+
+	galleryName := d.EqualsQuals["gallery_name"].GetStringValue()
+	applicationName := d.EqualsQuals["gallery_application_name"].GetStringValue()
+	name := d.EqualsQuals["name"].GetStringValue()
+	resourceGroup := d.EqualsQuals["resource_group"].GetStringValue()
+
+	// Handle empty galleryName, applicationName, name or resourceGroup
+	if galleryName == "" || applicationName == "" || name == "" || resourceGroup == "" {
+		return nil, nil
+	}
+
+	session, err := GetNewSession(ctx, d, "MANAGEMENT")
+	if err != nil {
+		return nil, err
+	}
+	subscriptionID := session.SubscriptionID
+
+	client := compute.NewGalleryApplicationVersionsClientWithBaseURI(session.ResourceManagerEndpoint, subscriptionID)
+	client.Authorizer = session.Authorizer
+
+	op, err := client.Get(ctx, resourceGroup, galleryName, applicationName, name, "")
+	if err != nil {
+		plugin.Logger(ctx).Error("getComputeGalleryApplicationVersion", "get", err)
+		return nil, err
+	}
+
+	// In some cases resource does not give any notFound error
+	// instead of notFound error, it returns empty data
+	if op.ID != nil {
+		return ComputeGalleryApplicationVersionInfo{op, &galleryName, &applicationName}, nil
+	}
+
+	return nil, nil
+}
+
+// The replication status is only returned when the version is fetched with the
+// ReplicationStatus expand option, and the SDK marshals it as an empty object
+func getComputeGalleryApplicationVersionReplicationStatus(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("getComputeGalleryApplicationVersionReplicationStatus")
+
+	data := h.Item.(ComputeGalleryApplicationVersionInfo)
+	resourceGroup := strings.Split(*data.ID, "/")[4]
+
+	session, err := GetNewSession(ctx, d, "MANAGEMENT")
+	if err != nil {
+		return nil, err
+	}
+	subscriptionID := session.SubscriptionID
+
+	client := compute.NewGalleryApplicationVersionsClientWithBaseURI(session.ResourceManagerEndpoint, subscriptionID)
+	client.Authorizer = session.Authorizer
+
+	op, err := client.Get(ctx, resourceGroup, *data.GalleryName, *data.GalleryApplicationName, *data.Name, compute.ReplicationStatusTypesReplicationStatus)
+	if err != nil {
+		plugin.Logger(ctx).Error("getComputeGalleryApplicationVersionReplicationStatus", "get", err)
+		return nil, err
+	}
+
+	if op.GalleryApplicationVersionProperties == nil || op.GalleryApplicationVersionProperties.ReplicationStatus == nil {
+		return nil, nil
+	}
+	status := op.GalleryApplicationVersionProperties.ReplicationStatus
+
+	summary := []map[string]interface{}{}
+	if status.Summary != nil {
+		for _, region := range *status.Summary {
+			regionStatus := map[string]interface{}{
+				"state": region.State,
+			}
+			if region.Region != nil {
+				regionStatus["region"] = *region.Region
+			}
+			if region.Details != nil {
+				regionStatus["details"] = *region.Details
+			}
+			if region.Progress != nil {
+				regionStatus["progress"] = *region.Progress
+			}
+			summary = append(summary, regionStatus)
+		}
+	}
+
+	return map[string]interface{}{
+		"aggregatedState": status.AggregatedState,
+		"summary":         summary,
+	}, nil
+}
@@ -128,6 +128,23 @@ func tableAzureComputeImage(_ context.Context) *plugin.Table {
 				Type:        proto.ColumnType_JSON,
 				Transform:   transform.FromField("ImageProperties.StorageProfile.DataDisks"),
 			},
+			{
+				Name:        "storage_profile_data_disks_count",
+				Description: "The number of data disks in the image",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.From(computeImageDataDisksCount),
+			},
+			{
+				Name:        "storage_profile",
+				Description: "Specifies the storage settings for the virtual machine disks",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("ImageProperties.StorageProfile"),
+			},
+			{
+				Name:        "extended_location",
+				Description: "The extended location of the image",
+				Type:        proto.ColumnType_JSON,
+			},
 
 			// Steampipe standard columns
 			{
@@ -241,3 +258,15 @@ func getComputeImage(ctx context.Context, d *plugin.QueryData, h *plugin.Hydrate
 
 	return nil, nil
 }
+
+//// TRANSFORM FUNCTIONS ////
+
+func computeImageDataDisksCount(ctx context.Context, d *transform.TransformData) (interface{}, error) {
+	image := d.HydrateItem.(compute.Image)
+
+	if image.ImageProperties == nil || image.ImageProperties.StorageProfile == nil || image.ImageProperties.StorageProfile.DataDisks == nil {
+		return 0, nil
+	}
+
+	return len(*image.ImageProperties.StorageProfile.DataDisks), nil
+}
@@ -46,6 +46,7 @@ func tableAzureComputeSnapshot(_ context.Context) *plugin.Table {
 				Name:        "provisioning_state",
 				Description: "The disk provisioning state",
 				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("SnapshotProperties.ProvisioningState"),
 			},
 			{
 				Name:        "create_option",
@@ -137,6 +138,12 @@ func tableAzureComputeSnapshot(_ context.Context) *plugin.Table {
 				Type:        proto.ColumnType_STRING,
 				Transform:   transform.FromField("SnapshotProperties.NetworkAccessPolicy").Transform(transform.ToString),
 			},
+			{
+				Name:        "public_network_access",
+				Description: "Policy for controlling export on the disk. Possible values include: 'Enabled', 'Disabled'",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("SnapshotProperties.PublicNetworkAccess").Transform(transform.ToString),
+			},
 			{
 				Name:        "os_type",
 				Description: "Contains the type of operating system",
@@ -197,6 +204,18 @@ func tableAzureComputeSnapshot(_ context.Context) *plugin.Table {
 				Type:        proto.ColumnType_INT,
 				Transform:   transform.FromField("SnapshotProperties.CreationData.UploadSizeBytes"),
 			},
+			{
+				Name:        "encryption",
+				Description: "Encryption property can be used to encrypt data at rest with customer managed keys or platform managed keys",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("SnapshotProperties.Encryption"),
+			},
+			{
+				Name:        "encryption_settings_collection",
+				Description: "Encryption settings collection used be Azure Disk Encryption, can contain multiple encryption settings per disk or snapshot",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("SnapshotProperties.EncryptionSettingsCollection"),
+			},
 			{
 				Name:        "encryption_settings",
 				Description: "A list of encryption settings, one for each disk volume",
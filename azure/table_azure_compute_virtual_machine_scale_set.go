@@ -102,6 +102,24 @@ func tableAzureComputeVirtualMachineScaleSet(_ context.Context) *plugin.Table {
 				Type:        proto.ColumnType_STRING,
 				Transform:   transform.FromField("VirtualMachineScaleSetProperties.UniqueID"),
 			},
+			{
+				Name:        "orchestration_mode",
+				Description: "Specifies the orchestration mode for the virtual machine scale set. Possible values include: 'Uniform', 'Flexible'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("VirtualMachineScaleSetProperties.OrchestrationMode"),
+			},
+			{
+				Name:        "zone_balance",
+				Description: "Whether to force strictly even Virtual Machine distribution cross x-zones in case there is zone outage.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("VirtualMachineScaleSetProperties.ZoneBalance"),
+			},
+			{
+				Name:        "automatic_instance_repairs_policy",
+				Description: "Policy for automatic repairs of the virtual machines in the scale set.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("VirtualMachineScaleSetProperties.AutomaticRepairsPolicy"),
+			},
 			{
 				Name:        "extensions",
 				Description: "Specifies the details of VM Scale Set Extensions.",
@@ -125,6 +143,12 @@ func tableAzureComputeVirtualMachineScaleSet(_ context.Context) *plugin.Table {
 				Type:        proto.ColumnType_JSON,
 				Transform:   transform.FromField("VirtualMachineScaleSetProperties.ScaleInPolicy"),
 			},
+			{
+				Name:        "spot_restore_policy",
+				Description: "Specifies the Spot Restore properties for the virtual machine scale set.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("VirtualMachineScaleSetProperties.SpotRestorePolicy"),
+			},
 			{
 				Name:        "tags_src",
 				Description: "Resource tags.",
@@ -161,6 +185,12 @@ func tableAzureComputeVirtualMachineScaleSet(_ context.Context) *plugin.Table {
 				Type:        proto.ColumnType_JSON,
 				Transform:   transform.FromField("VirtualMachineScaleSetProperties.VirtualMachineProfile.OsProfile"),
 			},
+			{
+				Name:        "virtual_machine_profile",
+				Description: "The virtual machine profile of the scale set, including the settings shared by all the virtual machines in the scale set.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("VirtualMachineScaleSetProperties.VirtualMachineProfile"),
+			},
 			{
 				Name:        "virtual_machine_storage_profile",
 				Description: "Specifies the storage settings for the virtual machine disks.",
@@ -0,0 +1,247 @@
+package azure
+
+import (
+	"context"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/profiles/latest/compute/mgmt/compute"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+type ComputeVirtualMachineScaleSetExtensionInfo = struct {
+	compute.VirtualMachineScaleSetExtension
+	ScaleSetName *string
+	Location     *string
+}
+
+//// TABLE DEFINITION
+
+func tableAzureComputeVirtualMachineScaleSetExtension(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "azure_compute_virtual_machine_scale_set_extension",
+		Description: "Azure Compute Virtual Machine Scale Set Extension",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.AllColumns([]string{"scale_set_name", "name", "resource_group"}),
+			Hydrate:    getAzureComputeVirtualMachineScaleSetExtension,
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: isNotFoundError([]string{"ResourceNotFound", "ResourceGroupNotFound", "404"}),
+			},
+		},
+		List: &plugin.ListConfig{
+			ParentHydrate: listAzureComputeVirtualMachineScaleSets,
+			Hydrate:       listAzureComputeVirtualMachineScaleSetExtensions,
+		},
+		Columns: azureColumns([]*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name of the extension.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "id",
+				Description: "The unique ID identifying the resource in a subscription.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromGo(),
+			},
+			{
+				Name:        "type",
+				Description: "The type of the resource in Azure.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "scale_set_name",
+				Description: "The name of the scale set the extension is installed on.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "publisher",
+				Description: "The name of the extension handler publisher, e.g. Microsoft.Azure.Monitor.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("VirtualMachineScaleSetExtensionProperties.Publisher"),
+			},
+			{
+				Name:        "type_properties",
+				Description: "The type of the extension, e.g. AzureMonitorLinuxAgent or CustomScript.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("VirtualMachineScaleSetExtensionProperties.Type"),
+			},
+			{
+				Name:        "type_handler_version",
+				Description: "The version of the script handler.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("VirtualMachineScaleSetExtensionProperties.TypeHandlerVersion"),
+			},
+			{
+				Name:        "auto_upgrade_minor_version",
+				Description: "Indicates whether the extension should use a newer minor version if one is available at deployment time.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("VirtualMachineScaleSetExtensionProperties.AutoUpgradeMinorVersion"),
+			},
+			{
+				Name:        "enable_automatic_upgrade",
+				Description: "Indicates whether the extension should be automatically upgraded by the platform if there is a newer version available.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("VirtualMachineScaleSetExtensionProperties.EnableAutomaticUpgrade"),
+			},
+			{
+				Name:        "provisioning_state",
+				Description: "The provisioning state of the extension.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("VirtualMachineScaleSetExtensionProperties.ProvisioningState"),
+			},
+			{
+				Name:        "force_update_tag",
+				Description: "If a value is provided and is different from the previous value, the extension handler is forced to update even if the extension configuration has not changed.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("VirtualMachineScaleSetExtensionProperties.ForceUpdateTag"),
+			},
+			{
+				Name:        "suppress_failures",
+				Description: "Indicates whether failures stemming from the extension are suppressed.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("VirtualMachineScaleSetExtensionProperties.SuppressFailures"),
+			},
+			{
+				Name:        "settings",
+				Description: "The public settings of the extension. Protected settings are not returned.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("VirtualMachineScaleSetExtensionProperties.Settings"),
+			},
+			{
+				Name:        "provision_after_extensions",
+				Description: "The names of the extensions after which this extension needs to be provisioned.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("VirtualMachineScaleSetExtensionProperties.ProvisionAfterExtensions"),
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+			{
+				Name:        "akas",
+				Description: ColumnDescriptionAkas,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("ID").Transform(idToAkas),
+			},
+
+			// Azure standard columns
+			{
+				Name:        "region",
+				Description: ColumnDescriptionRegion,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Location").Transform(toLower),
+			},
+			{
+				Name:        "resource_group",
+				Description: ColumnDescriptionResourceGroup,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ID").Transform(extractResourceGroupFromID),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listAzureComputeVirtualMachineScaleSetExtensions(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	session, err := GetNewSession(ctx, d, "MANAGEMENT")
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_compute_virtual_machine_scale_set_extension.listAzureComputeVirtualMachineScaleSetExtensions", "session_error", err)
+		return nil, err
+	}
+	subscriptionID := session.SubscriptionID
+
+	client := compute.NewVirtualMachineScaleSetExtensionsClientWithBaseURI(session.ResourceManagerEndpoint, subscriptionID)
+	client.Authorizer = session.Authorizer
+
+	scaleSet := h.Item.(compute.VirtualMachineScaleSet)
+	resourceGroupName := strings.Split(string(*scaleSet.ID), "/")[4]
+
+	result, err := client.List(ctx, resourceGroupName, *scaleSet.Name)
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_compute_virtual_machine_scale_set_extension.listAzureComputeVirtualMachineScaleSetExtensions", "api_error", err)
+		return nil, err
+	}
+
+	for _, extension := range result.Values() {
+		d.StreamListItem(ctx, ComputeVirtualMachineScaleSetExtensionInfo{extension, scaleSet.Name, scaleSet.Location})
+		// Check if context has been cancelled or if the limit has been hit (if specified)
+		// if there is a limit, it will return the number of rows required to reach this limit
+		if d.RowsRemaining(ctx) == 0 {
+			return nil, nil
+		}
+	}
+
+	for result.NotDone() {
+		err = result.NextWithContext(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("azure_compute_virtual_machine_scale_set_extension.listAzureComputeVirtualMachineScaleSetExtensions", "paging_error", err)
+			return nil, err
+		}
+		for _, extension := range result.Values() {
+			d.StreamListItem(ctx, ComputeVirtualMachineScaleSetExtensionInfo{extension, scaleSet.Name, scaleSet.Location})
+			// Check if context has been cancelled or if the limit has been hit (if specified)
+			// if there is a limit, it will return the number of rows required to reach this limit
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getAzureComputeVirtualMachineScaleSetExtension(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("getAzureComputeVirtualMachineScaleSetExtension")
+
+	scaleSetName := d.EqualsQualString("scale_set_name")
+	name := d.EqualsQualString("name")
+	resourceGroup := d.EqualsQualString("resource_group")
+
+	// Handle empty scaleSetName, name or resourceGroup
+	if scaleSetName == "" || name == "" || resourceGroup == "" {
+		return nil, nil
+	}
+
+	session, err := GetNewSession(ctx, d, "MANAGEMENT")
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_compute_virtual_machine_scale_set_extension.getAzureComputeVirtualMachineScaleSetExtension", "session_error", err)
+		return nil, err
+	}
+	subscriptionID := session.SubscriptionID
+
+	client := compute.NewVirtualMachineScaleSetExtensionsClientWithBaseURI(session.ResourceManagerEndpoint, subscriptionID)
+	client.Authorizer = session.Authorizer
+
+	op, err := client.Get(ctx, resourceGroup, scaleSetName, name, "")
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_compute_virtual_machine_scale_set_extension.getAzureComputeVirtualMachineScaleSetExtension", "api_error", err)
+		return nil, err
+	}
+
+	// In some cases resource does not give any notFound error
+	// instead of notFound error, it returns empty data
+	if op.ID == nil {
+		return nil, nil
+	}
+
+	// The extension does not return the location, so it is taken from the scale set
+	scaleSetClient := compute.NewVirtualMachineScaleSetsClientWithBaseURI(session.ResourceManagerEndpoint, subscriptionID)
+	scaleSetClient.Authorizer = session.Authorizer
+
+	scaleSet, err := scaleSetClient.Get(ctx, resourceGroup, scaleSetName, "")
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_compute_virtual_machine_scale_set_extension.getAzureComputeVirtualMachineScaleSetExtension", "api_error", err)
+		return nil, err
+	}
+
+	return ComputeVirtualMachineScaleSetExtensionInfo{op, scaleSet.Name, scaleSet.Location}, nil
+}
@@ -163,12 +163,37 @@ func tableAzureContainerRegistry(_ context.Context) *plugin.Table {
 				Type:        proto.ColumnType_JSON,
 				Transform:   transform.FromField("RegistryProperties.Policies"),
 			},
+			{
+				Name:        "policies_quarantine_status",
+				Description: "Indicates whether the quarantine policy is enabled for the container registry. Possible values include: 'enabled', 'disabled'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("RegistryProperties.Policies.QuarantinePolicy.Status").Transform(transform.ToString),
+			},
+			{
+				Name:        "policies_trust_status",
+				Description: "Indicates whether the content trust policy is enabled for the container registry. Possible values include: 'enabled', 'disabled'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("RegistryProperties.Policies.TrustPolicy.Status").Transform(transform.ToString),
+			},
+			{
+				Name:        "policies_retention_days",
+				Description: "The number of days to retain an untagged manifest after which it gets purged.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("RegistryProperties.Policies.RetentionPolicy.Days"),
+			},
 			{
 				Name:        "private_endpoint_connections",
 				Description: "A list of private endpoint connections for a container registry.",
 				Type:        proto.ColumnType_JSON,
 				Transform:   transform.FromField("RegistryProperties.PrivateEndpointConnections"),
 			},
+			{
+				Name:        "replications",
+				Description: "A list of geo-replications of the container registry.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     listContainerRegistryReplications,
+				Transform:   transform.FromValue(),
+			},
 			{
 				Name:        "system_data",
 				Description: "Metadata pertaining to creation and last modification of the resource.",
@@ -349,7 +374,7 @@ func listContainerRegistryWebhooks(ctx context.Context, d *plugin.QueryData, h *
 
 	webhooks := op.Values()
 
-	if op.NotDone() {
+	for op.NotDone() {
 		err = op.NextWithContext(ctx)
 		if err != nil {
 			plugin.Logger(ctx).Error("azure_container_registry.listContainerRegistryWebhooks", "api_paging_error", err)
@@ -362,6 +387,40 @@ func listContainerRegistryWebhooks(ctx context.Context, d *plugin.QueryData, h *
 	return webhooks, nil
 }
 
+func listContainerRegistryReplications(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	// Create session
+	session, err := GetNewSession(ctx, d, "MANAGEMENT")
+	if err != nil {
+		return nil, err
+	}
+	subscriptionID := session.SubscriptionID
+	client := containerregistry.NewReplicationsClientWithBaseURI(session.ResourceManagerEndpoint, subscriptionID)
+	client.Authorizer = session.Authorizer
+
+	data := h.Item.(containerregistry.Registry)
+	resourceGroup := strings.Split(*data.ID, "/")[4]
+
+	op, err := client.List(ctx, resourceGroup, *data.Name)
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_container_registry.listContainerRegistryReplications", "api_error", err)
+		return nil, err
+	}
+
+	replications := op.Values()
+
+	for op.NotDone() {
+		err = op.NextWithContext(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("azure_container_registry.listContainerRegistryReplications", "api_paging_error", err)
+			return nil, err
+		}
+
+		replications = append(replications, op.Values()...)
+	}
+
+	return replications, nil
+}
+
 func listContainerRegistryUsages(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
 	plugin.Logger(ctx).Trace("listContainerRegistryUsages")
 
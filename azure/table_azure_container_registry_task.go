@@ -0,0 +1,355 @@
+package azure
+
+import (
+	"context"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/profiles/latest/containerregistry/mgmt/containerregistry"
+	containerregistrypreview "github.com/Azure/azure-sdk-for-go/profiles/preview/preview/containerregistry/mgmt/containerregistry"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+)
+
+//// TABLE DEFINITION
+
+func tableAzureContainerRegistryTask(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "azure_container_registry_task",
+		Description: "Azure Container Registry Task",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.AllColumns([]string{"registry_name", "name", "resource_group"}),
+			Hydrate:    getContainerRegistryTask,
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: isNotFoundError([]string{"ResourceNotFound", "ResourceGroupNotFound", "404"}),
+			},
+		},
+		List: &plugin.ListConfig{
+			Hydrate:       listContainerRegistryTasks,
+			ParentHydrate: listContainerRegistries,
+		},
+		Columns: azureColumns([]*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name of the task.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "id",
+				Description: "The ID of the task.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromGo(),
+			},
+			{
+				Name:        "registry_name",
+				Description: "The name of the container registry the task belongs to.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "type",
+				Description: "The type of the resource.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "provisioning_state",
+				Description: "The provisioning state of the task. Possible values include: 'Creating', 'Updating', 'Deleting', 'Succeeded', 'Failed', 'Canceled'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("TaskProperties.ProvisioningState"),
+			},
+			{
+				Name:        "creation_date",
+				Description: "The creation date of the task.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("TaskProperties.CreationDate").Transform(convertDateToTime),
+			},
+			{
+				Name:        "status",
+				Description: "The current status of the task. Possible values include: 'Disabled', 'Enabled'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("TaskProperties.Status"),
+			},
+			{
+				Name:        "source_location",
+				Description: "The URL of the source context for the task step.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.From(extractContainerRegistryTaskSourceLocation),
+			},
+			{
+				Name:        "timeout_in_seconds",
+				Description: "Run timeout in seconds.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("TaskProperties.Timeout"),
+			},
+			{
+				Name:        "is_system_task",
+				Description: "Indicates whether the task is a quick task used for an ad-hoc run.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("TaskProperties.IsSystemTask"),
+			},
+			{
+				Name:        "log_template",
+				Description: "The template that describes the repository and tag information for the run log artifact.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("TaskProperties.LogTemplate"),
+			},
+			{
+				Name:        "agent_pool_name",
+				Description: "The dedicated agent pool for the task.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("TaskProperties.AgentPoolName"),
+			},
+			{
+				Name:        "platform",
+				Description: "The platform properties against which the run has to happen, including the OS and architecture.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("TaskProperties.Platform"),
+			},
+			{
+				Name:        "agent_configuration",
+				Description: "The machine configuration of the run agent.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("TaskProperties.AgentConfiguration"),
+			},
+			{
+				Name:        "trigger",
+				Description: "The properties that describe all triggers for the task, including source, base image and timer triggers.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("TaskProperties.Trigger"),
+			},
+			{
+				Name:        "step",
+				Description: "The properties of a task step, such as its type and the Docker file path. The context access token is not returned.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("TaskProperties.Step").Transform(maskContainerRegistryTaskStep),
+			},
+			{
+				Name:        "credentials",
+				Description: "The properties that describe the credentials used to access the source and custom registries. Opaque password values are masked.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("TaskProperties.Credentials").Transform(maskContainerRegistryTaskCredentials),
+			},
+			{
+				Name:        "identity",
+				Description: "The identity of the task.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "system_data",
+				Description: "Metadata pertaining to creation and last modification of the resource.",
+				Type:        proto.ColumnType_JSON,
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+			{
+				Name:        "tags",
+				Description: ColumnDescriptionTags,
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "akas",
+				Description: ColumnDescriptionAkas,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("ID").Transform(idToAkas),
+			},
+
+			// Azure standard columns
+			{
+				Name:        "region",
+				Description: ColumnDescriptionRegion,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Location").Transform(toLower),
+			},
+			{
+				Name:        "resource_group",
+				Description: ColumnDescriptionResourceGroup,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ID").Transform(extractResourceGroupFromID),
+			},
+		}),
+	}
+}
+
+type ContainerRegistryTaskInfo = struct {
+	containerregistrypreview.Task
+	RegistryName *string
+}
+
+//// LIST FUNCTION
+
+func listContainerRegistryTasks(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	// Get the details of container registry
+	registry := h.Item.(containerregistry.Registry)
+	resourceGroup := strings.Split(*registry.ID, "/")[4]
+
+	session, err := GetNewSession(ctx, d, "MANAGEMENT")
+	if err != nil {
+		return nil, err
+	}
+	subscriptionID := session.SubscriptionID
+
+	client := containerregistrypreview.NewTasksClientWithBaseURI(session.ResourceManagerEndpoint, subscriptionID)
+	client.Authorizer = session.Authorizer
+
+	result, err := client.List(ctx, resourceGroup, *registry.Name)
+	if err != nil {
+		plugin.Logger(ctx).Error("listContainerRegistryTasks", "list", err)
+		return nil, err
+	}
+
+	for _, task := range result.Values() {
+		d.StreamListItem(ctx, ContainerRegistryTaskInfo{task, registry.Name})
+		// Check if context has been cancelled or if the limit has been hit (if specified)
+		// if there is a limit, it will return the number of rows required to reach this limit
+		if d.RowsRemaining(ctx) == 0 {
+			return nil, nil
+		}
+	}
+
+	for result.NotDone() {
+		err = result.NextWithContext(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("listContainerRegistryTasks", "list_paging", err)
+			return nil, err
+		}
+		for _, task := range result.Values() {
+			d.StreamListItem(ctx, ContainerRegistryTaskInfo{task, registry.Name})
+			// Check if context has been cancelled or if the limit has been hit (if specified)
+			// if there is a limit, it will return the number of rows required to reach this limit
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, err
+}
+
+//// HYDRATE FUNCTIONS
+
+func getContainerRegistryTask(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("getContainerRegistryTask")
+
+	registryName := d.EqualsQuals["registry_name"].GetStringValue()
+	name := d.EqualsQuals["name"].GetStringValue()
+	resourceGroup := d.EqualsQuals["resource_group"].GetStringValue()
+
+	// Handle empty check
+	if registryName == "" || name == "" || resourceGroup == "" {
+		return nil, nil
+	}
+
+	session, err := GetNewSession(ctx, d, "MANAGEMENT")
+	if err != nil {
+		return nil, err
+	}
+	subscriptionID := session.SubscriptionID
+
+	client := containerregistrypreview.NewTasksClientWithBaseURI(session.ResourceManagerEndpoint, subscriptionID)
+	client.Authorizer = session.Authorizer
+
+	op, err := client.Get(ctx, resourceGroup, registryName, name)
+	if err != nil {
+		plugin.Logger(ctx).Error("getContainerRegistryTask", "get", err)
+		return nil, err
+	}
+
+	// In some cases resource does not give any notFound error
+	// instead of notFound error, it returns empty data
+	if op.ID != nil {
+		return ContainerRegistryTaskInfo{op, &registryName}, nil
+	}
+
+	return nil, nil
+}
+
+//// TRANSFORM FUNCTIONS
+
+func extractContainerRegistryTaskSourceLocation(ctx context.Context, d *transform.TransformData) (interface{}, error) {
+	task := d.HydrateItem.(ContainerRegistryTaskInfo)
+	if task.TaskProperties == nil || task.TaskProperties.Step == nil {
+		return nil, nil
+	}
+
+	if step, ok := task.TaskProperties.Step.AsDockerBuildStep(); ok {
+		return step.ContextPath, nil
+	}
+	if step, ok := task.TaskProperties.Step.AsFileTaskStep(); ok {
+		return step.ContextPath, nil
+	}
+	if step, ok := task.TaskProperties.Step.AsEncodedTaskStep(); ok {
+		return step.ContextPath, nil
+	}
+	if step, ok := task.TaskProperties.Step.AsTaskStepProperties(); ok {
+		return step.ContextPath, nil
+	}
+
+	return nil, nil
+}
+
+// The context access token grants access to the source repository, so it is never returned
+func maskContainerRegistryTaskStep(ctx context.Context, d *transform.TransformData) (interface{}, error) {
+	step, ok := d.Value.(containerregistrypreview.BasicTaskStepProperties)
+	if !ok || step == nil {
+		return nil, nil
+	}
+
+	if s, ok := step.AsDockerBuildStep(); ok {
+		masked := *s
+		masked.ContextAccessToken = nil
+		return masked, nil
+	}
+	if s, ok := step.AsFileTaskStep(); ok {
+		masked := *s
+		masked.ContextAccessToken = nil
+		return masked, nil
+	}
+	if s, ok := step.AsEncodedTaskStep(); ok {
+		masked := *s
+		masked.ContextAccessToken = nil
+		return masked, nil
+	}
+	if s, ok := step.AsTaskStepProperties(); ok {
+		masked := *s
+		masked.ContextAccessToken = nil
+		return masked, nil
+	}
+
+	return nil, nil
+}
+
+// Opaque secrets hold the password itself, whereas Key Vault secrets only hold the secret URI
+func maskContainerRegistryTaskCredentials(ctx context.Context, d *transform.TransformData) (interface{}, error) {
+	credentials, ok := d.Value.(*containerregistrypreview.Credentials)
+	if !ok || credentials == nil {
+		return nil, nil
+	}
+
+	masked := *credentials
+	if credentials.CustomRegistries != nil {
+		customRegistries := map[string]*containerregistrypreview.CustomRegistryCredentials{}
+		for registry, registryCredentials := range credentials.CustomRegistries {
+			if registryCredentials == nil {
+				continue
+			}
+			maskedCredentials := *registryCredentials
+			if registryCredentials.Password != nil && registryCredentials.Password.Type == containerregistrypreview.SecretObjectTypeOpaque {
+				maskedValue := "********"
+				maskedCredentials.Password = &containerregistrypreview.SecretObject{
+					Type:  registryCredentials.Password.Type,
+					Value: &maskedValue,
+				}
+			}
+			customRegistries[registry] = &maskedCredentials
+		}
+		masked.CustomRegistries = customRegistries
+	}
+
+	return masked, nil
+}
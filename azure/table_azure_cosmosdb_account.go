@@ -5,6 +5,7 @@ import (
 	"strings"
 
 	"github.com/Azure/azure-sdk-for-go/profiles/latest/cosmos-db/mgmt/documentdb"
+	"github.com/Azure/azure-sdk-for-go/profiles/preview/preview/monitor/mgmt/insights"
 	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
 	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
 
@@ -33,6 +34,13 @@ func tableAzureCosmosDBAccount(_ context.Context) *plugin.Table {
 		List: &plugin.ListConfig{
 			Hydrate: listCosmosDBAccounts,
 		},
+		HydrateConfig: []plugin.HydrateConfig{
+			{
+				Func:           listCosmosDBAccountDiagnosticSettings,
+				MaxConcurrency: diagnosticSettingsMaxConcurrency,
+				Tags:           map[string]string{"service": "Microsoft.Insights", "action": "diagnosticSettings/list"},
+			},
+		},
 		Columns: azureColumns([]*plugin.Column{
 			{
 				Name:        "name",
@@ -155,6 +163,12 @@ func tableAzureCosmosDBAccount(_ context.Context) *plugin.Table {
 				Type:        proto.ColumnType_STRING,
 				Transform:   transform.FromField("DatabaseAccount.DatabaseAccountGetProperties.KeyVaultKeyURI"),
 			},
+			{
+				Name:        "network_acl_bypass",
+				Description: "Indicates what services are allowed to bypass firewall checks. Possible values include: 'None', 'AzureServices'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("DatabaseAccount.DatabaseAccountGetProperties.NetworkACLBypass").Transform(transform.ToString),
+			},
 			{
 				Name:        "provisioning_state",
 				Description: "The provisioning state of the database account resource.",
@@ -185,12 +199,25 @@ func tableAzureCosmosDBAccount(_ context.Context) *plugin.Table {
 				Type:        proto.ColumnType_JSON,
 				Transform:   transform.FromField("DatabaseAccount.DatabaseAccountGetProperties.Capabilities"),
 			},
+			{
+				Name:        "consistency_policy",
+				Description: "The consistency policy of the Cosmos DB database account, with the default consistency level and the bounded staleness settings.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("DatabaseAccount.DatabaseAccountGetProperties.ConsistencyPolicy"),
+			},
 			{
 				Name:        "cors",
 				Description: "A list of CORS policy for the Cosmos DB database account.",
 				Type:        proto.ColumnType_JSON,
 				Transform:   transform.FromField("DatabaseAccount.DatabaseAccountGetProperties.Cors"),
 			},
+			{
+				Name:        "diagnostic_settings",
+				Description: "A list of active diagnostic settings for the Cosmos DB database account.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     listCosmosDBAccountDiagnosticSettings,
+				Transform:   transform.FromValue(),
+			},
 			{
 				Name:        "failover_policies",
 				Description: "A list of regions ordered by their failover priorities.",
@@ -209,6 +236,12 @@ func tableAzureCosmosDBAccount(_ context.Context) *plugin.Table {
 				Type:        proto.ColumnType_JSON,
 				Transform:   transform.FromField("DatabaseAccount.DatabaseAccountGetProperties.Locations"),
 			},
+			{
+				Name:        "network_acl_bypass_resource_ids",
+				Description: "A list of resource IDs that are allowed to bypass the firewall checks of the Cosmos DB database account.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("DatabaseAccount.DatabaseAccountGetProperties.NetworkACLBypassResourceIds"),
+			},
 			{
 				Name:        "private_endpoint_connections",
 				Description: "A list of Private Endpoint Connections configured for the Cosmos DB account.",
@@ -343,6 +376,48 @@ func getCosmosDBAccount(ctx context.Context, d *plugin.QueryData, h *plugin.Hydr
 	return databaseAccountInfo{op, op.Name, &resourceGroup}, nil
 }
 
+func listCosmosDBAccountDiagnosticSettings(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("listCosmosDBAccountDiagnosticSettings")
+	id := *h.Item.(databaseAccountInfo).DatabaseAccount.ID
+
+	// Create session
+	session, err := GetNewSession(ctx, d, "MANAGEMENT")
+	if err != nil {
+		return nil, err
+	}
+	subscriptionID := session.SubscriptionID
+
+	client := insights.NewDiagnosticSettingsClientWithBaseURI(session.ResourceManagerEndpoint, subscriptionID)
+	client.Authorizer = session.Authorizer
+
+	op, err := client.List(ctx, id)
+	if err != nil {
+		plugin.Logger(ctx).Error("listCosmosDBAccountDiagnosticSettings", "list", err)
+		return nil, err
+	}
+
+	// If we return the API response directly, the output does not provide all
+	// the contents of DiagnosticSettings
+	var diagnosticSettings []map[string]interface{}
+	for _, i := range *op.Value {
+		objectMap := make(map[string]interface{})
+		if i.ID != nil {
+			objectMap["id"] = i.ID
+		}
+		if i.Name != nil {
+			objectMap["name"] = i.Name
+		}
+		if i.Type != nil {
+			objectMap["type"] = i.Type
+		}
+		if i.DiagnosticSettings != nil {
+			objectMap["properties"] = i.DiagnosticSettings
+		}
+		diagnosticSettings = append(diagnosticSettings, objectMap)
+	}
+	return diagnosticSettings, nil
+}
+
 //// TRANSFORM FUNCTIONS
 
 func extractCosmosDBVirtualNetworkRule(ctx context.Context, d *transform.TransformData) (interface{}, error) {
@@ -404,3 +479,18 @@ func cosmosDBPrivateEndpointConnectionMap(ctx context.Context, d *transform.Tran
 
 	return privateEndpointConnections, nil
 }
+
+//// UTILITY FUNCTIONS
+
+// cosmosDBAccountHasCapability returns true if the capability, e.g. EnableTable or EnableGremlin, is enabled on the account
+func cosmosDBAccountHasCapability(account databaseAccountInfo, capability string) bool {
+	if account.DatabaseAccount.DatabaseAccountGetProperties == nil || account.DatabaseAccount.Capabilities == nil {
+		return false
+	}
+	for _, c := range *account.DatabaseAccount.Capabilities {
+		if c.Name != nil && *c.Name == capability {
+			return true
+		}
+	}
+	return false
+}
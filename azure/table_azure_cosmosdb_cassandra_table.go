@@ -0,0 +1,282 @@
+package azure
+
+import (
+	"context"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/profiles/latest/cosmos-db/mgmt/documentdb"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+)
+
+type cassandraTableInfo = struct {
+	CassandraTable documentdb.CassandraTableGetResults
+	Account        *string
+	Keyspace       *string
+	Name           *string
+	ResourceGroup  *string
+	Location       *string
+}
+
+//// TABLE DEFINITION
+
+func tableAzureCosmosDBCassandraTable(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "azure_cosmosdb_cassandra_table",
+		Description: "Azure Cosmos DB Cassandra Table",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.AllColumns([]string{"account_name", "name", "resource_group", "keyspace_name"}),
+			Hydrate:    getCosmosDBCassandraTable,
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: isNotFoundError([]string{"ResourceNotFound", "NotFound"}),
+			},
+		},
+		List: &plugin.ListConfig{
+			KeyColumns: plugin.KeyColumnSlice{
+				{
+					Name: "keyspace_name", Require: plugin.Optional,
+				},
+				{
+					Name: "account_name", Require: plugin.Optional,
+				},
+			},
+			ParentHydrate: listCosmosDBAccounts,
+			Hydrate:       listCosmosDBCassandraTables,
+		},
+		Columns: azureColumns([]*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The friendly name that identifies the Cassandra table.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "account_name",
+				Description: "The friendly name that identifies the cosmosdb account in which the table is created.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Account"),
+			},
+			{
+				Name:        "keyspace_name",
+				Description: "The friendly name that identifies the keyspace in which the table is created.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Keyspace"),
+			},
+			{
+				Name:        "id",
+				Description: "Contains ID to identify a Cassandra table uniquely.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("CassandraTable.ID"),
+			},
+			{
+				Name:        "type",
+				Description: "Type of the resource.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("CassandraTable.Type"),
+			},
+			{
+				Name:        "resource_id",
+				Description: "Name of the Cosmos DB Cassandra table.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("CassandraTable.CassandraTableGetProperties.Resource.ID"),
+			},
+			{
+				Name:        "resource_etag",
+				Description: "A system generated property representing the resource etag required for optimistic concurrency control.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("CassandraTable.CassandraTableGetProperties.Resource.Etag"),
+			},
+			{
+				Name:        "resource_rid",
+				Description: "A system generated unique identifier for the table.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("CassandraTable.CassandraTableGetProperties.Resource.Rid"),
+			},
+			{
+				Name:        "resource_ts",
+				Description: "A system generated property that denotes the last updated timestamp of the resource.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("CassandraTable.CassandraTableGetProperties.Resource.Ts").Transform(transform.ToInt),
+			},
+			{
+				Name:        "default_ttl",
+				Description: "The default time to live of the table, in seconds.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("CassandraTable.CassandraTableGetProperties.Resource.DefaultTTL"),
+			},
+			{
+				Name:        "analytical_storage_ttl",
+				Description: "Analytical TTL.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("CassandraTable.CassandraTableGetProperties.Resource.AnalyticalStorageTTL"),
+			},
+			{
+				Name:        "throughput",
+				Description: "The provisioned throughput of the table, if it is provisioned with manual throughput.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("CassandraTable.CassandraTableGetProperties.Options.Throughput"),
+			},
+			{
+				Name:        "autoscale_settings",
+				Description: "The autoscale settings of the table, if it is provisioned with autoscale throughput.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("CassandraTable.CassandraTableGetProperties.Options.AutoscaleSettings"),
+			},
+			{
+				Name:        "schema",
+				Description: "The schema of the table, with its columns, partition keys and cluster keys.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("CassandraTable.CassandraTableGetProperties.Resource.Schema"),
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+			{
+				Name:        "tags",
+				Description: ColumnDescriptionTags,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("CassandraTable.Tags"),
+			},
+			{
+				Name:        "akas",
+				Description: ColumnDescriptionAkas,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("CassandraTable.ID").Transform(idToAkas),
+			},
+
+			// Azure standard columns
+			{
+				Name:        "region",
+				Description: ColumnDescriptionRegion,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Location").Transform(toLower),
+			},
+			{
+				Name:        "resource_group",
+				Description: ColumnDescriptionResourceGroup,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ResourceGroup").Transform(toLower),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listCosmosDBCassandraTables(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	logger := plugin.Logger(ctx)
+	account := h.Item.(databaseAccountInfo)
+
+	// Cassandra resources only exist in accounts with the Cassandra API capability
+	if !cosmosDBAccountHasCapability(account, "EnableCassandra") {
+		return nil, nil
+	}
+
+	// Validate is hydrate account name matches the user provided account name
+	if d.EqualsQuals["account_name"] != nil && d.EqualsQualString("account_name") != *account.Name {
+		return nil, nil
+	}
+
+	session, err := GetNewSession(ctx, d, "MANAGEMENT")
+	if err != nil {
+		logger.Error("azure_cosmosdb_cassandra_table.listCosmosDBCassandraTables", "session_error", err)
+		return nil, err
+	}
+	subscriptionID := session.SubscriptionID
+
+	documentDBClient := documentdb.NewCassandraResourcesClientWithBaseURI(session.ResourceManagerEndpoint, subscriptionID)
+	documentDBClient.Authorizer = session.Authorizer
+
+	// List the tables of all the keyspaces of the account, unless the keyspace name is specified in the query parameter
+	keyspaceNames := []string{}
+	if d.EqualsQualString("keyspace_name") != "" {
+		keyspaceNames = append(keyspaceNames, d.EqualsQualString("keyspace_name"))
+	} else {
+		keyspaces, err := documentDBClient.ListCassandraKeyspaces(ctx, *account.ResourceGroup, *account.Name)
+		if err != nil {
+			logger.Error("azure_cosmosdb_cassandra_table.listCosmosDBCassandraTables", "list_keyspaces_error", err)
+			return nil, err
+		}
+		if keyspaces.Value != nil {
+			for _, keyspace := range *keyspaces.Value {
+				keyspaceNames = append(keyspaceNames, *keyspace.Name)
+			}
+		}
+	}
+
+	for _, keyspaceName := range keyspaceNames {
+		keyspaceName := keyspaceName
+		result, err := documentDBClient.ListCassandraTables(ctx, *account.ResourceGroup, *account.Name, keyspaceName)
+		if err != nil {
+			logger.Error("azure_cosmosdb_cassandra_table.listCosmosDBCassandraTables", "api_error", err)
+			return nil, err
+		}
+		if result.Value == nil {
+			continue
+		}
+
+		for _, table := range *result.Value {
+			resourceGroup := &strings.Split(string(*table.ID), "/")[4]
+			d.StreamLeafListItem(ctx, cassandraTableInfo{table, account.Name, &keyspaceName, table.Name, resourceGroup, account.DatabaseAccount.Location})
+
+			// Check if context has been cancelled or if the limit has been hit (if specified)
+			// if there is a limit, it will return the number of rows required to reach this limit
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getCosmosDBCassandraTable(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	logger := plugin.Logger(ctx)
+
+	name := d.EqualsQuals["name"].GetStringValue()
+	resourceGroup := d.EqualsQuals["resource_group"].GetStringValue()
+	accountName := d.EqualsQuals["account_name"].GetStringValue()
+	keyspaceName := d.EqualsQuals["keyspace_name"].GetStringValue()
+
+	// Length of Account name must be greater than, or equal to 3
+	if len(accountName) < 3 || len(resourceGroup) < 1 || len(name) < 1 || len(keyspaceName) < 1 {
+		return nil, nil
+	}
+
+	session, err := GetNewSession(ctx, d, "MANAGEMENT")
+	if err != nil {
+		logger.Error("azure_cosmosdb_cassandra_table.getCosmosDBCassandraTable", "session_error", err)
+		return nil, err
+	}
+	subscriptionID := session.SubscriptionID
+
+	databaseAccountClient := documentdb.NewDatabaseAccountsClientWithBaseURI(session.ResourceManagerEndpoint, subscriptionID)
+	databaseAccountClient.Authorizer = session.Authorizer
+
+	op, err := databaseAccountClient.Get(ctx, resourceGroup, accountName)
+	if err != nil {
+		logger.Error("azure_cosmosdb_cassandra_table.getCosmosDBCassandraTable", "get_account_error", err)
+		return nil, err
+	}
+
+	location := op.Location
+
+	documentDBClient := documentdb.NewCassandraResourcesClientWithBaseURI(session.ResourceManagerEndpoint, subscriptionID)
+	documentDBClient.Authorizer = session.Authorizer
+
+	result, err := documentDBClient.GetCassandraTable(ctx, resourceGroup, accountName, keyspaceName, name)
+	if err != nil {
+		logger.Error("azure_cosmosdb_cassandra_table.getCosmosDBCassandraTable", "api_error", err)
+		return nil, err
+	}
+
+	return cassandraTableInfo{result, &accountName, &keyspaceName, result.Name, &resourceGroup, location}, nil
+}
@@ -0,0 +1,238 @@
+package azure
+
+import (
+	"context"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/profiles/latest/cosmos-db/mgmt/documentdb"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+)
+
+type gremlinDatabaseInfo = struct {
+	GremlinDatabase documentdb.GremlinDatabaseGetResults
+	Account         *string
+	Name            *string
+	ResourceGroup   *string
+	Location        *string
+}
+
+//// TABLE DEFINITION
+
+func tableAzureCosmosDBGremlinDatabase(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "azure_cosmosdb_gremlin_database",
+		Description: "Azure Cosmos DB Gremlin Database",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.AllColumns([]string{"account_name", "name", "resource_group"}),
+			Hydrate:    getCosmosDBGremlinDatabase,
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: isNotFoundError([]string{"ResourceNotFound", "NotFound"}),
+			},
+		},
+		List: &plugin.ListConfig{
+			KeyColumns: plugin.KeyColumnSlice{
+				{
+					Name: "account_name", Require: plugin.Optional,
+				},
+			},
+			ParentHydrate: listCosmosDBAccounts,
+			Hydrate:       listCosmosDBGremlinDatabases,
+		},
+		Columns: azureColumns([]*plugin.Column{
+			{
+				Name:        "name",
+				Type:        proto.ColumnType_STRING,
+				Description: "The friendly name that identifies the Gremlin database.",
+			},
+			{
+				Name:        "account_name",
+				Type:        proto.ColumnType_STRING,
+				Description: "The friendly name that identifies the database account in which the database is created.",
+				Transform:   transform.FromField("Account"),
+			},
+			{
+				Name:        "id",
+				Description: "Contains ID to identify a Gremlin database uniquely.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("GremlinDatabase.ID"),
+			},
+			{
+				Name:        "type",
+				Description: "Type of the resource.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("GremlinDatabase.Type"),
+			},
+			{
+				Name:        "resource_id",
+				Description: "Name of the Cosmos DB Gremlin database.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("GremlinDatabase.GremlinDatabaseGetProperties.Resource.ID"),
+			},
+			{
+				Name:        "resource_etag",
+				Description: "A system generated property representing the resource etag required for optimistic concurrency control.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("GremlinDatabase.GremlinDatabaseGetProperties.Resource.Etag"),
+			},
+			{
+				Name:        "resource_rid",
+				Description: "A system generated unique identifier for the database.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("GremlinDatabase.GremlinDatabaseGetProperties.Resource.Rid"),
+			},
+			{
+				Name:        "resource_ts",
+				Description: "A system generated property that denotes the last updated timestamp of the resource.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("GremlinDatabase.GremlinDatabaseGetProperties.Resource.Ts").Transform(transform.ToInt),
+			},
+			{
+				Name:        "throughput",
+				Description: "The provisioned throughput of the database, if it is provisioned with manual throughput.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("GremlinDatabase.GremlinDatabaseGetProperties.Options.Throughput"),
+			},
+			{
+				Name:        "autoscale_settings_max_throughput",
+				Description: "Contains maximum throughput, the resource can scale up to.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("GremlinDatabase.GremlinDatabaseGetProperties.Options.AutoscaleSettings.MaxThroughput"),
+			},
+			{
+				Name:        "autoscale_settings",
+				Description: "The autoscale settings of the database, if it is provisioned with autoscale throughput.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("GremlinDatabase.GremlinDatabaseGetProperties.Options.AutoscaleSettings"),
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+			{
+				Name:        "tags",
+				Description: ColumnDescriptionTags,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("GremlinDatabase.Tags"),
+			},
+			{
+				Name:        "akas",
+				Description: ColumnDescriptionAkas,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("GremlinDatabase.ID").Transform(idToAkas),
+			},
+
+			// Azure standard columns
+			{
+				Name:        "region",
+				Description: ColumnDescriptionRegion,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Location").Transform(toLower),
+			},
+			{
+				Name:        "resource_group",
+				Description: ColumnDescriptionResourceGroup,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ResourceGroup").Transform(toLower),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listCosmosDBGremlinDatabases(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	logger := plugin.Logger(ctx)
+	account := h.Item.(databaseAccountInfo)
+
+	// Gremlin resources only exist in accounts with the Gremlin API capability
+	if !cosmosDBAccountHasCapability(account, "EnableGremlin") {
+		return nil, nil
+	}
+
+	// Validate is hydrate account name matches the user provided account name
+	if d.EqualsQuals["account_name"] != nil && d.EqualsQualString("account_name") != *account.Name {
+		return nil, nil
+	}
+
+	session, err := GetNewSession(ctx, d, "MANAGEMENT")
+	if err != nil {
+		logger.Error("azure_cosmosdb_gremlin_database.listCosmosDBGremlinDatabases", "session_error", err)
+		return nil, err
+	}
+	subscriptionID := session.SubscriptionID
+
+	documentDBClient := documentdb.NewGremlinResourcesClientWithBaseURI(session.ResourceManagerEndpoint, subscriptionID)
+	documentDBClient.Authorizer = session.Authorizer
+
+	result, err := documentDBClient.ListGremlinDatabases(ctx, *account.ResourceGroup, *account.Name)
+	if err != nil {
+		logger.Error("azure_cosmosdb_gremlin_database.listCosmosDBGremlinDatabases", "api_error", err)
+		return nil, err
+	}
+	if result.Value == nil {
+		return nil, nil
+	}
+
+	for _, database := range *result.Value {
+		resourceGroup := &strings.Split(string(*database.ID), "/")[4]
+		d.StreamLeafListItem(ctx, gremlinDatabaseInfo{database, account.Name, database.Name, resourceGroup, account.DatabaseAccount.Location})
+		// Check if context has been cancelled or if the limit has been hit (if specified)
+		// if there is a limit, it will return the number of rows required to reach this limit
+		if d.RowsRemaining(ctx) == 0 {
+			return nil, nil
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getCosmosDBGremlinDatabase(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	logger := plugin.Logger(ctx)
+
+	name := d.EqualsQuals["name"].GetStringValue()
+	resourceGroup := d.EqualsQuals["resource_group"].GetStringValue()
+	accountName := d.EqualsQuals["account_name"].GetStringValue()
+
+	// Length of Account name must be greater than, or equal to 3
+	if len(accountName) < 3 || len(resourceGroup) < 1 || len(name) < 1 {
+		return nil, nil
+	}
+
+	session, err := GetNewSession(ctx, d, "MANAGEMENT")
+	if err != nil {
+		logger.Error("azure_cosmosdb_gremlin_database.getCosmosDBGremlinDatabase", "session_error", err)
+		return nil, err
+	}
+	subscriptionID := session.SubscriptionID
+
+	databaseAccountClient := documentdb.NewDatabaseAccountsClientWithBaseURI(session.ResourceManagerEndpoint, subscriptionID)
+	databaseAccountClient.Authorizer = session.Authorizer
+
+	op, err := databaseAccountClient.Get(ctx, resourceGroup, accountName)
+	if err != nil {
+		logger.Error("azure_cosmosdb_gremlin_database.getCosmosDBGremlinDatabase", "get_account_error", err)
+		return nil, err
+	}
+
+	location := op.Location
+
+	documentDBClient := documentdb.NewGremlinResourcesClientWithBaseURI(session.ResourceManagerEndpoint, subscriptionID)
+	documentDBClient.Authorizer = session.Authorizer
+
+	result, err := documentDBClient.GetGremlinDatabase(ctx, resourceGroup, accountName, name)
+	if err != nil {
+		logger.Error("azure_cosmosdb_gremlin_database.getCosmosDBGremlinDatabase", "api_error", err)
+		return nil, err
+	}
+
+	return gremlinDatabaseInfo{result, &accountName, result.Name, &resourceGroup, location}, nil
+}
@@ -0,0 +1,300 @@
+package azure
+
+import (
+	"context"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/profiles/latest/cosmos-db/mgmt/documentdb"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+)
+
+type gremlinGraphInfo = struct {
+	GremlinGraph  documentdb.GremlinGraphGetResults
+	Account       *string
+	Database      *string
+	Name          *string
+	ResourceGroup *string
+	Location      *string
+}
+
+//// TABLE DEFINITION
+
+func tableAzureCosmosDBGremlinGraph(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "azure_cosmosdb_gremlin_graph",
+		Description: "Azure Cosmos DB Gremlin Graph",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.AllColumns([]string{"account_name", "name", "resource_group", "database_name"}),
+			Hydrate:    getCosmosDBGremlinGraph,
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: isNotFoundError([]string{"ResourceNotFound", "NotFound"}),
+			},
+		},
+		List: &plugin.ListConfig{
+			KeyColumns: plugin.KeyColumnSlice{
+				{
+					Name: "database_name", Require: plugin.Optional,
+				},
+				{
+					Name: "account_name", Require: plugin.Optional,
+				},
+			},
+			ParentHydrate: listCosmosDBAccounts,
+			Hydrate:       listCosmosDBGremlinGraphs,
+		},
+		Columns: azureColumns([]*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The friendly name that identifies the Gremlin graph.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "account_name",
+				Description: "The friendly name that identifies the cosmosdb account in which the graph is created.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Account"),
+			},
+			{
+				Name:        "database_name",
+				Description: "The friendly name that identifies the database in which the graph is created.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Database"),
+			},
+			{
+				Name:        "id",
+				Description: "Contains ID to identify a Gremlin graph uniquely.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("GremlinGraph.ID"),
+			},
+			{
+				Name:        "type",
+				Description: "Type of the resource.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("GremlinGraph.Type"),
+			},
+			{
+				Name:        "resource_id",
+				Description: "Name of the Cosmos DB Gremlin graph.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("GremlinGraph.GremlinGraphGetProperties.Resource.ID"),
+			},
+			{
+				Name:        "resource_etag",
+				Description: "A system generated property representing the resource etag required for optimistic concurrency control.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("GremlinGraph.GremlinGraphGetProperties.Resource.Etag"),
+			},
+			{
+				Name:        "resource_rid",
+				Description: "A system generated unique identifier for the graph.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("GremlinGraph.GremlinGraphGetProperties.Resource.Rid"),
+			},
+			{
+				Name:        "resource_ts",
+				Description: "A system generated property that denotes the last updated timestamp of the resource.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("GremlinGraph.GremlinGraphGetProperties.Resource.Ts").Transform(transform.ToInt),
+			},
+			{
+				Name:        "default_ttl",
+				Description: "The default time to live of the graph, in seconds.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("GremlinGraph.GremlinGraphGetProperties.Resource.DefaultTTL"),
+			},
+			{
+				Name:        "analytical_storage_ttl",
+				Description: "Analytical TTL.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("GremlinGraph.GremlinGraphGetProperties.Resource.AnalyticalStorageTTL"),
+			},
+			{
+				Name:        "throughput",
+				Description: "The provisioned throughput of the graph, if it is provisioned with manual throughput.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("GremlinGraph.GremlinGraphGetProperties.Options.Throughput"),
+			},
+			{
+				Name:        "autoscale_settings",
+				Description: "The autoscale settings of the graph, if it is provisioned with autoscale throughput.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("GremlinGraph.GremlinGraphGetProperties.Options.AutoscaleSettings"),
+			},
+			{
+				Name:        "partition_key",
+				Description: "The partition key of the graph, used to distribute vertices and edges across partitions.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("GremlinGraph.GremlinGraphGetProperties.Resource.PartitionKey"),
+			},
+			{
+				Name:        "indexing_policy",
+				Description: "The configuration of the indexing policy of the graph.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("GremlinGraph.GremlinGraphGetProperties.Resource.IndexingPolicy"),
+			},
+			{
+				Name:        "unique_key_policy",
+				Description: "The unique key policy of the graph, which enforces the uniqueness of one or more values per logical partition.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("GremlinGraph.GremlinGraphGetProperties.Resource.UniqueKeyPolicy"),
+			},
+			{
+				Name:        "conflict_resolution_policy",
+				Description: "The conflict resolution policy of the graph, used when the account has multiple write regions.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("GremlinGraph.GremlinGraphGetProperties.Resource.ConflictResolutionPolicy"),
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+			{
+				Name:        "tags",
+				Description: ColumnDescriptionTags,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("GremlinGraph.Tags"),
+			},
+			{
+				Name:        "akas",
+				Description: ColumnDescriptionAkas,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("GremlinGraph.ID").Transform(idToAkas),
+			},
+
+			// Azure standard columns
+			{
+				Name:        "region",
+				Description: ColumnDescriptionRegion,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Location").Transform(toLower),
+			},
+			{
+				Name:        "resource_group",
+				Description: ColumnDescriptionResourceGroup,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ResourceGroup").Transform(toLower),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listCosmosDBGremlinGraphs(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	logger := plugin.Logger(ctx)
+	account := h.Item.(databaseAccountInfo)
+
+	// Gremlin resources only exist in accounts with the Gremlin API capability
+	if !cosmosDBAccountHasCapability(account, "EnableGremlin") {
+		return nil, nil
+	}
+
+	// Validate is hydrate account name matches the user provided account name
+	if d.EqualsQuals["account_name"] != nil && d.EqualsQualString("account_name") != *account.Name {
+		return nil, nil
+	}
+
+	session, err := GetNewSession(ctx, d, "MANAGEMENT")
+	if err != nil {
+		logger.Error("azure_cosmosdb_gremlin_graph.listCosmosDBGremlinGraphs", "session_error", err)
+		return nil, err
+	}
+	subscriptionID := session.SubscriptionID
+
+	documentDBClient := documentdb.NewGremlinResourcesClientWithBaseURI(session.ResourceManagerEndpoint, subscriptionID)
+	documentDBClient.Authorizer = session.Authorizer
+
+	// List the graphs of all the databases of the account, unless the database name is specified in the query parameter
+	databaseNames := []string{}
+	if d.EqualsQualString("database_name") != "" {
+		databaseNames = append(databaseNames, d.EqualsQualString("database_name"))
+	} else {
+		databases, err := documentDBClient.ListGremlinDatabases(ctx, *account.ResourceGroup, *account.Name)
+		if err != nil {
+			logger.Error("azure_cosmosdb_gremlin_graph.listCosmosDBGremlinGraphs", "list_databases_error", err)
+			return nil, err
+		}
+		if databases.Value != nil {
+			for _, database := range *databases.Value {
+				databaseNames = append(databaseNames, *database.Name)
+			}
+		}
+	}
+
+	for _, databaseName := range databaseNames {
+		databaseName := databaseName
+		result, err := documentDBClient.ListGremlinGraphs(ctx, *account.ResourceGroup, *account.Name, databaseName)
+		if err != nil {
+			logger.Error("azure_cosmosdb_gremlin_graph.listCosmosDBGremlinGraphs", "api_error", err)
+			return nil, err
+		}
+		if result.Value == nil {
+			continue
+		}
+
+		for _, graph := range *result.Value {
+			resourceGroup := &strings.Split(string(*graph.ID), "/")[4]
+			d.StreamLeafListItem(ctx, gremlinGraphInfo{graph, account.Name, &databaseName, graph.Name, resourceGroup, account.DatabaseAccount.Location})
+
+			// Check if context has been cancelled or if the limit has been hit (if specified)
+			// if there is a limit, it will return the number of rows required to reach this limit
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getCosmosDBGremlinGraph(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	logger := plugin.Logger(ctx)
+
+	name := d.EqualsQuals["name"].GetStringValue()
+	resourceGroup := d.EqualsQuals["resource_group"].GetStringValue()
+	accountName := d.EqualsQuals["account_name"].GetStringValue()
+	databaseName := d.EqualsQuals["database_name"].GetStringValue()
+
+	// Length of Account name must be greater than, or equal to 3
+	if len(accountName) < 3 || len(resourceGroup) < 1 || len(name) < 1 || len(databaseName) < 1 {
+		return nil, nil
+	}
+
+	session, err := GetNewSession(ctx, d, "MANAGEMENT")
+	if err != nil {
+		logger.Error("azure_cosmosdb_gremlin_graph.getCosmosDBGremlinGraph", "session_error", err)
+		return nil, err
+	}
+	subscriptionID := session.SubscriptionID
+
+	databaseAccountClient := documentdb.NewDatabaseAccountsClientWithBaseURI(session.ResourceManagerEndpoint, subscriptionID)
+	databaseAccountClient.Authorizer = session.Authorizer
+
+	op, err := databaseAccountClient.Get(ctx, resourceGroup, accountName)
+	if err != nil {
+		logger.Error("azure_cosmosdb_gremlin_graph.getCosmosDBGremlinGraph", "get_account_error", err)
+		return nil, err
+	}
+
+	location := op.Location
+
+	documentDBClient := documentdb.NewGremlinResourcesClientWithBaseURI(session.ResourceManagerEndpoint, subscriptionID)
+	documentDBClient.Authorizer = session.Authorizer
+
+	result, err := documentDBClient.GetGremlinGraph(ctx, resourceGroup, accountName, databaseName, name)
+	if err != nil {
+		logger.Error("azure_cosmosdb_gremlin_graph.getCosmosDBGremlinGraph", "api_error", err)
+		return nil, err
+	}
+
+	return gremlinGraphInfo{result, &accountName, &databaseName, result.Name, &resourceGroup, location}, nil
+}
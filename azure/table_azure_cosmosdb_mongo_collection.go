@@ -36,7 +36,7 @@ func tableAzureCosmosDBMongoCollection(_ context.Context) *plugin.Table {
 		List: &plugin.ListConfig{
 			KeyColumns: plugin.KeyColumnSlice{
 				{
-					Name: "database_name", Require: plugin.Required,
+					Name: "database_name", Require: plugin.Optional,
 				},
 				{
 					Name: "account_name", Require: plugin.Optional,
@@ -87,6 +87,12 @@ func tableAzureCosmosDBMongoCollection(_ context.Context) *plugin.Table {
 				Type:        proto.ColumnType_INT,
 				Transform:   transform.FromField("MongoCollection.MongoDBCollectionGetProperties.Options.AutoscaleSettings.MaxThroughput"),
 			},
+			{
+				Name:        "autoscale_settings",
+				Description: "The autoscale settings of the collection, if it is provisioned with autoscale throughput.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("MongoCollection.MongoDBCollectionGetProperties.Options.AutoscaleSettings"),
+			},
 			{
 				Name:        "collection_etag",
 				Description: "A system generated property representing the resource etag required for optimistic concurrency control.",
@@ -180,10 +186,9 @@ func listCosmosDBMongoCollections(ctx context.Context, d *plugin.QueryData, h *p
 	// Get the details of cosmos db account
 	logger := plugin.Logger(ctx)
 	account := h.Item.(databaseAccountInfo)
-	databaseName := d.EqualsQuals["database_name"].GetStringValue()
 
-	// Validate user input for databaseName
-	if databaseName == "" {
+	// Mongo resources only exist in accounts of kind MongoDB
+	if account.DatabaseAccount.Kind != documentdb.DatabaseAccountKindMongoDB {
 		return nil, nil
 	}
 
@@ -203,20 +208,40 @@ func listCosmosDBMongoCollections(ctx context.Context, d *plugin.QueryData, h *p
 	documentDBClient := documentdb.NewMongoDBResourcesClientWithBaseURI(session.ResourceManagerEndpoint, subscriptionID)
 	documentDBClient.Authorizer = session.Authorizer
 
-	result, err := documentDBClient.ListMongoDBCollections(ctx, *account.ResourceGroup, *account.Name, databaseName)
-	if err != nil {
-		logger.Error("azure_cosmosdb_mongo_collection.listCosmosDBMongoCollections", "api_error", err)
-		return nil, err
+	// List the collections of all the databases of the account, unless the database name is specified in the query parameter
+	databaseNames := []string{}
+	if d.EqualsQualString("database_name") != "" {
+		databaseNames = append(databaseNames, d.EqualsQualString("database_name"))
+	} else {
+		databases, err := documentDBClient.ListMongoDBDatabases(ctx, *account.ResourceGroup, *account.Name)
+		if err != nil {
+			logger.Error("azure_cosmosdb_mongo_collection.listCosmosDBMongoCollections", "list_databases_error", err)
+			return nil, err
+		}
+		if databases.Value != nil {
+			for _, database := range *databases.Value {
+				databaseNames = append(databaseNames, *database.Name)
+			}
+		}
 	}
 
-	for _, mongoCollection := range *result.Value {
-		resourceGroup := &strings.Split(string(*mongoCollection.ID), "/")[4]
-		d.StreamLeafListItem(ctx, mongoCollectionInfo{mongoCollection, account.Name, &databaseName, mongoCollection.Name, resourceGroup, account.DatabaseAccount.Location})
+	for _, databaseName := range databaseNames {
+		databaseName := databaseName
+		result, err := documentDBClient.ListMongoDBCollections(ctx, *account.ResourceGroup, *account.Name, databaseName)
+		if err != nil {
+			logger.Error("azure_cosmosdb_mongo_collection.listCosmosDBMongoCollections", "api_error", err)
+			return nil, err
+		}
+
+		for _, mongoCollection := range *result.Value {
+			resourceGroup := &strings.Split(string(*mongoCollection.ID), "/")[4]
+			d.StreamLeafListItem(ctx, mongoCollectionInfo{mongoCollection, account.Name, &databaseName, mongoCollection.Name, resourceGroup, account.DatabaseAccount.Location})
 
-		// Check if context has been cancelled or if the limit has been hit (if specified)
-		// if there is a limit, it will return the number of rows required to reach this limit
-		if d.RowsRemaining(ctx) == 0 {
-			return nil, nil
+			// Check if context has been cancelled or if the limit has been hit (if specified)
+			// if there is a limit, it will return the number of rows required to reach this limit
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
 		}
 	}
 
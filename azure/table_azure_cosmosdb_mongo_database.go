@@ -66,6 +66,12 @@ func tableAzureCosmosDBMongoDatabase(_ context.Context) *plugin.Table {
 				Type:        proto.ColumnType_INT,
 				Transform:   transform.FromField("MongoDatabase.MongoDBDatabaseGetProperties.Options.AutoscaleSettings.MaxThroughput"),
 			},
+			{
+				Name:        "autoscale_settings",
+				Description: "The autoscale settings of the database, if it is provisioned with autoscale throughput.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("MongoDatabase.MongoDBDatabaseGetProperties.Options.AutoscaleSettings"),
+			},
 			{
 				Name:        "database_etag",
 				Description: "A system generated property representing the resource etag required for optimistic concurrency control.",
@@ -147,6 +153,11 @@ func listCosmosDBMongoDatabases(ctx context.Context, d *plugin.QueryData, h *plu
 	// Get the details of cosmos db account
 	account := h.Item.(databaseAccountInfo)
 
+	// Mongo resources only exist in accounts of kind MongoDB
+	if account.DatabaseAccount.Kind != documentdb.DatabaseAccountKindMongoDB {
+		return nil, nil
+	}
+
 	session, err := GetNewSession(ctx, d, "MANAGEMENT")
 	if err != nil {
 		return nil, err
@@ -88,7 +88,7 @@ func tableAzureDataFactory(_ context.Context) *plugin.Table {
 				Name:        "encryption",
 				Description: "Properties to enable Customer Managed Key for the factory.",
 				Type:        proto.ColumnType_JSON,
-				Transform:   transform.FromField("FactoryProperties.EncryptionConfiguration"),
+				Transform:   transform.FromField("FactoryProperties.Encryption"),
 			},
 			{
 				Name:        "repo_configuration",
@@ -96,6 +96,47 @@ func tableAzureDataFactory(_ context.Context) *plugin.Table {
 				Type:        proto.ColumnType_JSON,
 				Transform:   transform.FromField("FactoryProperties.RepoConfiguration"),
 			},
+			{
+				Name:        "purview_configuration",
+				Description: "Purview information of the factory.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("FactoryProperties.PurviewConfiguration"),
+			},
+			{
+				Name:        "managed_virtual_networks",
+				Description: "List of managed virtual networks for data factory.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     listDataFactoryManagedVirtualNetworks,
+				Transform:   transform.FromValue(),
+			},
+			{
+				Name:        "managed_virtual_network_enabled",
+				Description: "Indicates whether a managed virtual network is configured for the factory.",
+				Type:        proto.ColumnType_BOOL,
+				Hydrate:     listDataFactoryManagedVirtualNetworks,
+				Transform:   transform.FromValue().Transform(dataFactoryManagedVirtualNetworkEnabled),
+			},
+			{
+				Name:        "integration_runtimes",
+				Description: "List of integration runtimes for data factory.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     listDataFactoryIntegrationRuntimes,
+				Transform:   transform.FromValue(),
+			},
+			{
+				Name:        "pipeline_count",
+				Description: "The number of pipelines in the factory.",
+				Type:        proto.ColumnType_INT,
+				Hydrate:     getDataFactoryPipelineAndTriggerCount,
+				Transform:   transform.FromField("PipelineCount"),
+			},
+			{
+				Name:        "trigger_count",
+				Description: "The number of triggers in the factory.",
+				Type:        proto.ColumnType_INT,
+				Hydrate:     getDataFactoryPipelineAndTriggerCount,
+				Transform:   transform.FromField("TriggerCount"),
+			},
 			{
 				Name:        "global_parameters",
 				Description: "List of parameters for factory.",
@@ -266,6 +307,196 @@ func listDataFactoryPrivateEndpointConnections(ctx context.Context, d *plugin.Qu
 	return connections, nil
 }
 
+func listDataFactoryManagedVirtualNetworks(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	factory := h.Item.(datafactory.Factory)
+	factoryName := factory.Name
+	resourceGroup := strings.Split(*factory.ID, "/")[4]
+
+	// Create session
+	session, err := GetNewSession(ctx, d, "MANAGEMENT")
+	if err != nil {
+		plugin.Logger(ctx).Error("listDataFactoryManagedVirtualNetworks", "connection", err)
+		return nil, err
+	}
+	subscriptionID := session.SubscriptionID
+
+	vnetClient := datafactory.NewManagedVirtualNetworksClientWithBaseURI(session.ResourceManagerEndpoint, subscriptionID)
+	vnetClient.Authorizer = session.Authorizer
+
+	op, err := vnetClient.ListByFactory(ctx, resourceGroup, *factoryName)
+	if err != nil {
+		plugin.Logger(ctx).Error("listDataFactoryManagedVirtualNetworks", "ListByFactory", err)
+		return nil, err
+	}
+
+	var vnets []map[string]interface{}
+	for _, vnet := range op.Values() {
+		vnets = append(vnets, factoryManagedVirtualNetworkMap(vnet))
+	}
+
+	for op.NotDone() {
+		err = op.NextWithContext(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("listDataFactoryManagedVirtualNetworks", "ListByFactory_pagination", err)
+			return nil, err
+		}
+		for _, vnet := range op.Values() {
+			vnets = append(vnets, factoryManagedVirtualNetworkMap(vnet))
+		}
+	}
+
+	return vnets, nil
+}
+
+func listDataFactoryIntegrationRuntimes(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	factory := h.Item.(datafactory.Factory)
+	factoryName := factory.Name
+	resourceGroup := strings.Split(*factory.ID, "/")[4]
+
+	// Create session
+	session, err := GetNewSession(ctx, d, "MANAGEMENT")
+	if err != nil {
+		plugin.Logger(ctx).Error("listDataFactoryIntegrationRuntimes", "connection", err)
+		return nil, err
+	}
+	subscriptionID := session.SubscriptionID
+
+	runtimeClient := datafactory.NewIntegrationRuntimesClientWithBaseURI(session.ResourceManagerEndpoint, subscriptionID)
+	runtimeClient.Authorizer = session.Authorizer
+
+	op, err := runtimeClient.ListByFactory(ctx, resourceGroup, *factoryName)
+	if err != nil {
+		plugin.Logger(ctx).Error("listDataFactoryIntegrationRuntimes", "ListByFactory", err)
+		return nil, err
+	}
+
+	var runtimes []map[string]interface{}
+	for _, runtime := range op.Values() {
+		runtimes = append(runtimes, factoryIntegrationRuntimeMap(runtime))
+	}
+
+	for op.NotDone() {
+		err = op.NextWithContext(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("listDataFactoryIntegrationRuntimes", "ListByFactory_pagination", err)
+			return nil, err
+		}
+		for _, runtime := range op.Values() {
+			runtimes = append(runtimes, factoryIntegrationRuntimeMap(runtime))
+		}
+	}
+
+	return runtimes, nil
+}
+
+func getDataFactoryPipelineAndTriggerCount(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	factory := h.Item.(datafactory.Factory)
+	factoryName := factory.Name
+	resourceGroup := strings.Split(*factory.ID, "/")[4]
+
+	// Create session
+	session, err := GetNewSession(ctx, d, "MANAGEMENT")
+	if err != nil {
+		plugin.Logger(ctx).Error("getDataFactoryPipelineAndTriggerCount", "connection", err)
+		return nil, err
+	}
+	subscriptionID := session.SubscriptionID
+
+	pipelineClient := datafactory.NewPipelinesClientWithBaseURI(session.ResourceManagerEndpoint, subscriptionID)
+	pipelineClient.Authorizer = session.Authorizer
+
+	pipelines, err := pipelineClient.ListByFactory(ctx, resourceGroup, *factoryName)
+	if err != nil {
+		plugin.Logger(ctx).Error("getDataFactoryPipelineAndTriggerCount", "pipeline_ListByFactory", err)
+		return nil, err
+	}
+
+	var counts dataFactoryCountInfo
+	counts.PipelineCount = len(pipelines.Values())
+
+	for pipelines.NotDone() {
+		err = pipelines.NextWithContext(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("getDataFactoryPipelineAndTriggerCount", "pipeline_ListByFactory_pagination", err)
+			return nil, err
+		}
+		counts.PipelineCount += len(pipelines.Values())
+	}
+
+	triggerClient := datafactory.NewTriggersClientWithBaseURI(session.ResourceManagerEndpoint, subscriptionID)
+	triggerClient.Authorizer = session.Authorizer
+
+	triggers, err := triggerClient.ListByFactory(ctx, resourceGroup, *factoryName)
+	if err != nil {
+		plugin.Logger(ctx).Error("getDataFactoryPipelineAndTriggerCount", "trigger_ListByFactory", err)
+		return nil, err
+	}
+
+	counts.TriggerCount = len(triggers.Values())
+
+	for triggers.NotDone() {
+		err = triggers.NextWithContext(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("getDataFactoryPipelineAndTriggerCount", "trigger_ListByFactory_pagination", err)
+			return nil, err
+		}
+		counts.TriggerCount += len(triggers.Values())
+	}
+
+	return counts, nil
+}
+
+// If we return the API response directly, the output will not give
+// the read-only properties of ManagedVirtualNetwork
+func factoryManagedVirtualNetworkMap(vnet datafactory.ManagedVirtualNetworkResource) map[string]interface{} {
+	objectMap := make(map[string]interface{})
+	if vnet.ID != nil {
+		objectMap["id"] = vnet.ID
+	}
+	if vnet.Name != nil {
+		objectMap["name"] = vnet.Name
+	}
+	if vnet.Type != nil {
+		objectMap["type"] = vnet.Type
+	}
+	if vnet.Etag != nil {
+		objectMap["etag"] = vnet.Etag
+	}
+	if vnet.Properties != nil {
+		if vnet.Properties.VNetID != nil {
+			objectMap["vNetId"] = vnet.Properties.VNetID
+		}
+		if vnet.Properties.Alias != nil {
+			objectMap["alias"] = vnet.Properties.Alias
+		}
+	}
+
+	return objectMap
+}
+
+// If we return the API response directly, the output will not give
+// the read-only properties of IntegrationRuntime
+func factoryIntegrationRuntimeMap(runtime datafactory.IntegrationRuntimeResource) map[string]interface{} {
+	objectMap := make(map[string]interface{})
+	if runtime.ID != nil {
+		objectMap["id"] = runtime.ID
+	}
+	if runtime.Name != nil {
+		objectMap["name"] = runtime.Name
+	}
+	if runtime.Type != nil {
+		objectMap["type"] = runtime.Type
+	}
+	if runtime.Etag != nil {
+		objectMap["etag"] = runtime.Etag
+	}
+	if runtime.Properties != nil {
+		objectMap["properties"] = runtime.Properties
+	}
+
+	return objectMap
+}
+
 // If we return the API response directly, the output will not give
 // all the properties of PrivateEndpointConnection
 func factoryPrivateEndpointConnectionMap(conn datafactory.PrivateEndpointConnectionResource) PrivateConnection {
@@ -318,3 +549,19 @@ type PrivateConnection struct {
 	PrivateEndpointConnectionType                    *string
 	Etag                                             *string
 }
+
+type dataFactoryCountInfo struct {
+	PipelineCount int
+	TriggerCount  int
+}
+
+//// TRANSFORM FUNCTIONS
+
+func dataFactoryManagedVirtualNetworkEnabled(ctx context.Context, d *transform.TransformData) (interface{}, error) {
+	vnets, ok := d.Value.([]map[string]interface{})
+	if !ok {
+		return false, nil
+	}
+
+	return len(vnets) > 0, nil
+}
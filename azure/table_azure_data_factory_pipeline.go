@@ -69,9 +69,9 @@ func tableAzureDataFactoryPipeline(_ context.Context) *plugin.Table {
 			},
 			{
 				Name:        "pipeline_folder",
-				Description: "The folder that this Pipeline is in. If not specified, Pipeline will appear at the root level.",
+				Description: "[DEPRECATED] This column has been deprecated and will be removed in a future release, use folder instead. The folder that this Pipeline is in. If not specified, Pipeline will appear at the root level.",
 				Type:        proto.ColumnType_STRING,
-				Transform:   transform.FromField("Pipeline.Folder.PipelineFolder"),
+				Transform:   transform.FromField("Pipeline.Folder.Name"),
 			},
 			{
 				Name:        "activities",
@@ -93,9 +93,21 @@ func tableAzureDataFactoryPipeline(_ context.Context) *plugin.Table {
 			},
 			{
 				Name:        "pipeline_policy",
-				Description: "Pipeline ElapsedTime Metric Policy.",
+				Description: "[DEPRECATED] This column has been deprecated and will be removed in a future release, use policy instead. Pipeline ElapsedTime Metric Policy.",
 				Type:        proto.ColumnType_JSON,
-				Transform:   transform.FromField("Pipeline.Folder.PipelinePolicy"),
+				Transform:   transform.FromField("Pipeline.Policy"),
+			},
+			{
+				Name:        "folder",
+				Description: "The folder that this Pipeline is in.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Pipeline.Folder"),
+			},
+			{
+				Name:        "policy",
+				Description: "The policy of the pipeline, including the elapsed time metric policy.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Pipeline.Policy"),
 			},
 			{
 				Name:        "variables",
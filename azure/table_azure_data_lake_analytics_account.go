@@ -29,6 +29,13 @@ func tableAzureDataLakeAnalyticsAccount(_ context.Context) *plugin.Table {
 		List: &plugin.ListConfig{
 			Hydrate: listDataLakeAnalyticsAccounts,
 		},
+		HydrateConfig: []plugin.HydrateConfig{
+			{
+				Func:           listDataLakeAnalyticsAccountDiagnosticSettings,
+				MaxConcurrency: diagnosticSettingsMaxConcurrency,
+				Tags:           map[string]string{"service": "Microsoft.Insights", "action": "diagnosticSettings/list"},
+			},
+		},
 		Columns: azureColumns([]*plugin.Column{
 			{
 				Name:        "name",
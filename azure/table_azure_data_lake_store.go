@@ -28,6 +28,13 @@ func tableAzureDataLakeStore(_ context.Context) *plugin.Table {
 		List: &plugin.ListConfig{
 			Hydrate: listDataLakeStores,
 		},
+		HydrateConfig: []plugin.HydrateConfig{
+			{
+				Func:           listDataLakeStoreDiagnosticSettings,
+				MaxConcurrency: diagnosticSettingsMaxConcurrency,
+				Tags:           map[string]string{"service": "Microsoft.Insights", "action": "diagnosticSettings/list"},
+			},
+		},
 		Columns: azureColumns([]*plugin.Column{
 			{
 				Name:        "name",
@@ -2,6 +2,7 @@ package azure
 
 import (
 	"context"
+	"strings"
 
 	"github.com/Azure/azure-sdk-for-go/profiles/latest/dns/mgmt/dns"
 	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
@@ -90,6 +91,13 @@ func tableAzureDNSZone(_ context.Context) *plugin.Table {
 				Type:        proto.ColumnType_JSON,
 				Transform:   transform.FromField("ZoneProperties.ResolutionVirtualNetworks"),
 			},
+			{
+				Name:        "record_set_summary",
+				Description: "The number of record sets in the DNS zone, grouped by record type.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getDNSZoneRecordSetSummary,
+				Transform:   transform.FromValue(),
+			},
 
 			// Steampipe standard columns
 			{
@@ -202,3 +210,50 @@ func getDNSZone(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData)
 
 	return nil, nil
 }
+
+func getDNSZoneRecordSetSummary(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("getDNSZoneRecordSetSummary")
+
+	zone := h.Item.(dns.Zone)
+	resourceGroup := strings.Split(*zone.ID, "/")[4]
+
+	session, err := GetNewSession(ctx, d, "MANAGEMENT")
+	if err != nil {
+		return nil, err
+	}
+	subscriptionID := session.SubscriptionID
+
+	recordSetClient := dns.NewRecordSetsClientWithBaseURI(session.ResourceManagerEndpoint, subscriptionID)
+	recordSetClient.Authorizer = session.Authorizer
+
+	result, err := recordSetClient.ListAllByDNSZone(ctx, resourceGroup, *zone.Name, nil, "")
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_dns_zone.getDNSZoneRecordSetSummary", "api_error", err)
+		return nil, err
+	}
+
+	// Record set types are returned as "Microsoft.Network/dnszones/<record type>"
+	summary := make(map[string]int)
+	for _, recordSet := range result.Values() {
+		if recordSet.Type != nil {
+			recordType := (*recordSet.Type)[strings.LastIndex(*recordSet.Type, "/")+1:]
+			summary[recordType]++
+		}
+	}
+
+	for result.NotDone() {
+		err = result.NextWithContext(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("azure_dns_zone.getDNSZoneRecordSetSummary", "api_paging_error", err)
+			return nil, err
+		}
+		for _, recordSet := range result.Values() {
+			if recordSet.Type != nil {
+				recordType := (*recordSet.Type)[strings.LastIndex(*recordSet.Type, "/")+1:]
+				summary[recordType]++
+			}
+		}
+	}
+
+	return summary, nil
+}
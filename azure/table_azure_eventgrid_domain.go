@@ -26,6 +26,13 @@ func tableAzureEventGridDomain(_ context.Context) *plugin.Table {
 		List: &plugin.ListConfig{
 			Hydrate: listEventGridDomains,
 		},
+		HydrateConfig: []plugin.HydrateConfig{
+			{
+				Func:           listEventGridDiagnosticSettings,
+				MaxConcurrency: diagnosticSettingsMaxConcurrency,
+				Tags:           map[string]string{"service": "Microsoft.Insights", "action": "diagnosticSettings/list"},
+			},
+		},
 		Columns: azureColumns([]*plugin.Column{
 			{
 				Name:        "name",
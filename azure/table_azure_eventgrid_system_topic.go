@@ -0,0 +1,261 @@
+package azure
+
+import (
+	"context"
+
+	"github.com/Azure/azure-sdk-for-go/profiles/latest/eventgrid/mgmt/eventgrid"
+	"github.com/Azure/azure-sdk-for-go/profiles/preview/preview/monitor/mgmt/insights"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAzureEventGridSystemTopic(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "azure_eventgrid_system_topic",
+		Description: "Azure Event Grid System Topic",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.AllColumns([]string{"name", "resource_group"}),
+			Hydrate:    getEventGridSystemTopic,
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: isNotFoundError([]string{"ResourceGroupNotFound", "ResourceNotFound", "400", "404"}),
+			},
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listEventGridSystemTopics,
+		},
+		HydrateConfig: []plugin.HydrateConfig{
+			{
+				Func:           listEventGridSystemTopicDiagnosticSettings,
+				MaxConcurrency: diagnosticSettingsMaxConcurrency,
+				Tags:           map[string]string{"service": "Microsoft.Insights", "action": "diagnosticSettings/list"},
+			},
+		},
+		Columns: azureColumns([]*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name of the resource.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "id",
+				Description: "Fully qualified identifier of the resource.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromGo(),
+			},
+			{
+				Name:        "type",
+				Description: "The resource type.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "provisioning_state",
+				Description: "Provisioning state of the system topic. Possible values include: 'Creating', 'Updating', 'Deleting', 'Succeeded', 'Canceled', 'Failed'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("SystemTopicProperties.ProvisioningState"),
+			},
+			{
+				Name:        "source",
+				Description: "The ID of the Azure resource that publishes the events of the system topic.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("SystemTopicProperties.Source"),
+			},
+			{
+				Name:        "topic_type",
+				Description: "The type of the events published by the system topic, e.g. 'Microsoft.Storage.StorageAccounts'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("SystemTopicProperties.TopicType"),
+			},
+			{
+				Name:        "metric_resource_id",
+				Description: "The metric resource ID of the system topic.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("SystemTopicProperties.MetricResourceID"),
+			},
+			{
+				Name:        "created_at",
+				Description: "The timestamp of resource creation (UTC).",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("SystemData.CreatedAt").Transform(convertDateToTime),
+			},
+			{
+				Name:        "created_by",
+				Description: "The identity that created the resource.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("SystemData.CreatedBy"),
+			},
+			{
+				Name:        "diagnostic_settings",
+				Description: "A list of active diagnostic settings for the system topic.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     listEventGridSystemTopicDiagnosticSettings,
+				Transform:   transform.FromValue(),
+			},
+			{
+				Name:        "identity",
+				Description: "Identity information for the resource.",
+				Type:        proto.ColumnType_JSON,
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+			{
+				Name:        "tags",
+				Description: ColumnDescriptionTags,
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "akas",
+				Description: ColumnDescriptionAkas,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("ID").Transform(idToAkas),
+			},
+
+			// Azure standard columns
+			{
+				Name:        "region",
+				Description: ColumnDescriptionRegion,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Location").Transform(formatRegion).Transform(toLower),
+			},
+			{
+				Name:        "resource_group",
+				Description: ColumnDescriptionResourceGroup,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ID").Transform(extractResourceGroupFromID),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listEventGridSystemTopics(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("listEventGridSystemTopics")
+
+	// Create session
+	session, err := GetNewSession(ctx, d, "MANAGEMENT")
+	if err != nil {
+		return nil, err
+	}
+
+	subscriptionID := session.SubscriptionID
+	client := eventgrid.NewSystemTopicsClientWithBaseURI(session.ResourceManagerEndpoint, subscriptionID)
+	client.Authorizer = session.Authorizer
+
+	result, err := client.ListBySubscription(ctx, "", nil)
+	if err != nil {
+		plugin.Logger(ctx).Error("listEventGridSystemTopics", "ListBySubscription", err)
+		return nil, err
+	}
+
+	for _, systemTopic := range result.Values() {
+		d.StreamListItem(ctx, systemTopic)
+		// Check if context has been cancelled or if the limit has been hit (if specified)
+		// if there is a limit, it will return the number of rows required to reach this limit
+		if d.RowsRemaining(ctx) == 0 {
+			return nil, nil
+		}
+	}
+
+	for result.NotDone() {
+		err = result.NextWithContext(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("listEventGridSystemTopics", "ListBySubscription_pagination", err)
+			return nil, err
+		}
+
+		for _, systemTopic := range result.Values() {
+			d.StreamListItem(ctx, systemTopic)
+			// Check if context has been cancelled or if the limit has been hit (if specified)
+			// if there is a limit, it will return the number of rows required to reach this limit
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getEventGridSystemTopic(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("getEventGridSystemTopic")
+
+	name := d.EqualsQuals["name"].GetStringValue()
+	resourceGroup := d.EqualsQuals["resource_group"].GetStringValue()
+
+	// Return nil, if no input provided
+	if name == "" || resourceGroup == "" {
+		return nil, nil
+	}
+
+	// Create session
+	session, err := GetNewSession(ctx, d, "MANAGEMENT")
+	if err != nil {
+		return nil, err
+	}
+	subscriptionID := session.SubscriptionID
+	client := eventgrid.NewSystemTopicsClientWithBaseURI(session.ResourceManagerEndpoint, subscriptionID)
+	client.Authorizer = session.Authorizer
+
+	op, err := client.Get(ctx, resourceGroup, name)
+	if err != nil {
+		plugin.Logger(ctx).Error("getEventGridSystemTopic", "get", err)
+		return nil, err
+	}
+
+	return op, nil
+}
+
+func listEventGridSystemTopicDiagnosticSettings(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("listEventGridSystemTopicDiagnosticSettings")
+	id := *h.Item.(eventgrid.SystemTopic).ID
+
+	// Create session
+	session, err := GetNewSession(ctx, d, "MANAGEMENT")
+	if err != nil {
+		return nil, err
+	}
+	subscriptionID := session.SubscriptionID
+
+	client := insights.NewDiagnosticSettingsClientWithBaseURI(session.ResourceManagerEndpoint, subscriptionID)
+	client.Authorizer = session.Authorizer
+
+	// Pagination is not supported
+	op, err := client.List(ctx, id)
+	if err != nil {
+		plugin.Logger(ctx).Error("listEventGridSystemTopicDiagnosticSettings", "list", err)
+		return nil, err
+	}
+
+	// If we return the API response directly, the output does not provide
+	// all the contents of DiagnosticSettings
+	var diagnosticSettings []map[string]interface{}
+	for _, i := range *op.Value {
+		objectMap := make(map[string]interface{})
+		if i.ID != nil {
+			objectMap["id"] = i.ID
+		}
+		if i.Name != nil {
+			objectMap["name"] = i.Name
+		}
+		if i.Type != nil {
+			objectMap["type"] = i.Type
+		}
+		if i.DiagnosticSettings != nil {
+			objectMap["properties"] = i.DiagnosticSettings
+		}
+		diagnosticSettings = append(diagnosticSettings, objectMap)
+	}
+	return diagnosticSettings, nil
+}
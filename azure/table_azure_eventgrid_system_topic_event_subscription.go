@@ -0,0 +1,274 @@
+package azure
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/profiles/latest/eventgrid/mgmt/eventgrid"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+type EventGridSystemTopicEventSubscriptionInfo = struct {
+	eventgrid.EventSubscription
+	SystemTopicName *string
+	Location        *string
+}
+
+//// TABLE DEFINITION
+
+func tableAzureEventGridSystemTopicEventSubscription(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "azure_eventgrid_system_topic_event_subscription",
+		Description: "Azure Event Grid System Topic Event Subscription",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.AllColumns([]string{"system_topic_name", "name", "resource_group"}),
+			Hydrate:    getEventGridSystemTopicEventSubscription,
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: isNotFoundError([]string{"ResourceGroupNotFound", "ResourceNotFound", "400", "404"}),
+			},
+		},
+		List: &plugin.ListConfig{
+			ParentHydrate: listEventGridSystemTopics,
+			Hydrate:       listEventGridSystemTopicEventSubscriptions,
+		},
+		Columns: azureColumns([]*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name of the event subscription.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "id",
+				Description: "Fully qualified identifier of the event subscription.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromGo(),
+			},
+			{
+				Name:        "type",
+				Description: "The resource type.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "system_topic_name",
+				Description: "The name of the system topic the event subscription belongs to.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "provisioning_state",
+				Description: "Provisioning state of the event subscription. Possible values include: 'Creating', 'Updating', 'Deleting', 'Succeeded', 'Canceled', 'Failed', 'AwaitingManualAction'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("EventSubscriptionProperties.ProvisioningState"),
+			},
+			{
+				Name:        "topic",
+				Description: "The ID of the source resource of the events.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("EventSubscriptionProperties.Topic"),
+			},
+			{
+				Name:        "endpoint_type",
+				Description: "The type of the endpoint the events are delivered to. Possible values include: 'WebHook', 'EventHub', 'StorageQueue', 'HybridConnection', 'ServiceBusQueue', 'ServiceBusTopic', 'AzureFunction'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.From(extractEventGridEventSubscriptionEndpointType),
+			},
+			{
+				Name:        "event_delivery_schema",
+				Description: "The event delivery schema of the event subscription. Possible values include: 'EventGridSchema', 'CustomInputSchema', 'CloudEventSchemaV1_0'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("EventSubscriptionProperties.EventDeliverySchema"),
+			},
+			{
+				Name:        "expiration_time_utc",
+				Description: "The expiration time of the event subscription.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("EventSubscriptionProperties.ExpirationTimeUtc").Transform(convertDateToTime),
+			},
+			{
+				Name:        "destination",
+				Description: "Information about the destination where events are delivered.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("EventSubscriptionProperties.Destination"),
+			},
+			{
+				Name:        "delivery_with_resource_identity",
+				Description: "Information about the destination where events are delivered using the managed identity of the system topic.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("EventSubscriptionProperties.DeliveryWithResourceIdentity"),
+			},
+			{
+				Name:        "filter",
+				Description: "Information about the filter of the event subscription.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("EventSubscriptionProperties.Filter"),
+			},
+			{
+				Name:        "retry_policy",
+				Description: "The retry policy of the event subscription, with the maximum number of delivery attempts and the time to live of the events.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("EventSubscriptionProperties.RetryPolicy"),
+			},
+			{
+				Name:        "dead_letter_destination",
+				Description: "The destination of the events that cannot be delivered.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("EventSubscriptionProperties.DeadLetterDestination"),
+			},
+			{
+				Name:        "labels",
+				Description: "The list of user defined labels.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("EventSubscriptionProperties.Labels"),
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+			{
+				Name:        "akas",
+				Description: ColumnDescriptionAkas,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("ID").Transform(idToAkas),
+			},
+
+			// Azure standard columns
+			{
+				Name:        "region",
+				Description: ColumnDescriptionRegion,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Location").Transform(formatRegion).Transform(toLower),
+			},
+			{
+				Name:        "resource_group",
+				Description: ColumnDescriptionResourceGroup,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ID").Transform(extractResourceGroupFromID),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listEventGridSystemTopicEventSubscriptions(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	systemTopic := h.Item.(eventgrid.SystemTopic)
+	resourceGroup := strings.Split(*systemTopic.ID, "/")[4]
+
+	// Create session
+	session, err := GetNewSession(ctx, d, "MANAGEMENT")
+	if err != nil {
+		return nil, err
+	}
+
+	subscriptionID := session.SubscriptionID
+	client := eventgrid.NewSystemTopicEventSubscriptionsClientWithBaseURI(session.ResourceManagerEndpoint, subscriptionID)
+	client.Authorizer = session.Authorizer
+
+	result, err := client.ListBySystemTopic(ctx, resourceGroup, *systemTopic.Name, "", nil)
+	if err != nil {
+		plugin.Logger(ctx).Error("listEventGridSystemTopicEventSubscriptions", "ListBySystemTopic", err)
+		return nil, err
+	}
+
+	for _, subscription := range result.Values() {
+		d.StreamListItem(ctx, EventGridSystemTopicEventSubscriptionInfo{subscription, systemTopic.Name, systemTopic.Location})
+		// Check if context has been cancelled or if the limit has been hit (if specified)
+		// if there is a limit, it will return the number of rows required to reach this limit
+		if d.RowsRemaining(ctx) == 0 {
+			return nil, nil
+		}
+	}
+
+	for result.NotDone() {
+		err = result.NextWithContext(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("listEventGridSystemTopicEventSubscriptions", "ListBySystemTopic_pagination", err)
+			return nil, err
+		}
+
+		for _, subscription := range result.Values() {
+			d.StreamListItem(ctx, EventGridSystemTopicEventSubscriptionInfo{subscription, systemTopic.Name, systemTopic.Location})
+			// Check if context has been cancelled or if the limit has been hit (if specified)
+			// if there is a limit, it will return the number of rows required to reach this limit
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getEventGridSystemTopicEventSubscription(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("getEventGridSystemTopicEventSubscription")
+
+	systemTopicName := d.EqualsQuals["system_topic_name"].GetStringValue()
+	name := d.EqualsQuals["name"].GetStringValue()
+	resourceGroup := d.EqualsQuals["resource_group"].GetStringValue()
+
+	// Return nil, if no input provided
+	if systemTopicName == "" || name == "" || resourceGroup == "" {
+		return nil, nil
+	}
+
+	// Create session
+	session, err := GetNewSession(ctx, d, "MANAGEMENT")
+	if err != nil {
+		return nil, err
+	}
+	subscriptionID := session.SubscriptionID
+
+	// The event subscription does not return the location, so it is taken from the system topic
+	systemTopicClient := eventgrid.NewSystemTopicsClientWithBaseURI(session.ResourceManagerEndpoint, subscriptionID)
+	systemTopicClient.Authorizer = session.Authorizer
+
+	systemTopic, err := systemTopicClient.Get(ctx, resourceGroup, systemTopicName)
+	if err != nil {
+		plugin.Logger(ctx).Error("getEventGridSystemTopicEventSubscription", "get_system_topic", err)
+		return nil, err
+	}
+
+	client := eventgrid.NewSystemTopicEventSubscriptionsClientWithBaseURI(session.ResourceManagerEndpoint, subscriptionID)
+	client.Authorizer = session.Authorizer
+
+	op, err := client.Get(ctx, resourceGroup, systemTopicName, name)
+	if err != nil {
+		plugin.Logger(ctx).Error("getEventGridSystemTopicEventSubscription", "get", err)
+		return nil, err
+	}
+
+	return EventGridSystemTopicEventSubscriptionInfo{op, systemTopic.Name, systemTopic.Location}, nil
+}
+
+//// TRANSFORM FUNCTIONS
+
+// The destination is one of several endpoint specific types, which all set their endpoint type when marshalled
+func extractEventGridEventSubscriptionEndpointType(ctx context.Context, d *transform.TransformData) (interface{}, error) {
+	subscription := d.HydrateItem.(EventGridSystemTopicEventSubscriptionInfo)
+	if subscription.EventSubscriptionProperties == nil || subscription.Destination == nil {
+		return nil, nil
+	}
+
+	data, err := json.Marshal(subscription.Destination)
+	if err != nil {
+		return nil, err
+	}
+
+	var destination struct {
+		EndpointType string `json:"endpointType"`
+	}
+	if err := json.Unmarshal(data, &destination); err != nil {
+		return nil, err
+	}
+
+	return destination.EndpointType, nil
+}
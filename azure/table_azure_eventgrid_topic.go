@@ -27,6 +27,13 @@ func tableAzureEventGridTopic(_ context.Context) *plugin.Table {
 		List: &plugin.ListConfig{
 			Hydrate: listEventGridTopics,
 		},
+		HydrateConfig: []plugin.HydrateConfig{
+			{
+				Func:           listEventGridTopicDiagnosticSettings,
+				MaxConcurrency: diagnosticSettingsMaxConcurrency,
+				Tags:           map[string]string{"service": "Microsoft.Insights", "action": "diagnosticSettings/list"},
+			},
+		},
 		Columns: azureColumns([]*plugin.Column{
 			{
 				Name:        "name",
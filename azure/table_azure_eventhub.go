@@ -0,0 +1,310 @@
+package azure
+
+import (
+	"context"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/profiles/preview/preview/eventhub/mgmt/eventhub"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+)
+
+//// TABLE DEFINITION
+
+func tableAzureEventHub(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "azure_eventhub",
+		Description: "Azure Event Hub",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.AllColumns([]string{"namespace_name", "name", "resource_group"}),
+			Hydrate:    getEventHub,
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: isNotFoundError([]string{"ResourceNotFound", "ResourceGroupNotFound", "404"}),
+			},
+		},
+		List: &plugin.ListConfig{
+			Hydrate:       listEventHubs,
+			ParentHydrate: listEventHubNamespaces,
+		},
+		Columns: azureColumns([]*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name of the event hub.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "id",
+				Description: "The ID of the event hub.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromGo(),
+			},
+			{
+				Name:        "namespace_name",
+				Description: "The name of the Event Hubs namespace the event hub belongs to.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "type",
+				Description: "The type of the resource.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "status",
+				Description: "The status of the event hub. Possible values include: 'Active', 'Disabled', 'Restoring', 'SendDisabled', 'ReceiveDisabled', 'Creating', 'Deleting', 'Renaming', 'Unknown'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties.Status"),
+			},
+			{
+				Name:        "created_at",
+				Description: "The time the event hub was created.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("Properties.CreatedAt").Transform(convertDateToTime),
+			},
+			{
+				Name:        "updated_at",
+				Description: "The time the event hub was last updated.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("Properties.UpdatedAt").Transform(convertDateToTime),
+			},
+			{
+				Name:        "message_retention_in_days",
+				Description: "The number of days to retain the events for the event hub.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("Properties.MessageRetentionInDays"),
+			},
+			{
+				Name:        "partition_count",
+				Description: "The number of partitions created for the event hub.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("Properties.PartitionCount"),
+			},
+			{
+				Name:        "capture_enabled",
+				Description: "Indicates whether capture is enabled for the event hub.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("Properties.CaptureDescription.Enabled"),
+			},
+			{
+				Name:        "partition_ids",
+				Description: "The identifiers of the partitions created for the event hub.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Properties.PartitionIds"),
+			},
+			{
+				Name:        "capture_description",
+				Description: "The capture settings of the event hub, including the encoding, time and size windows, and the storage account and blob container the events are captured to.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Properties.CaptureDescription"),
+			},
+			{
+				Name:        "consumer_groups",
+				Description: "The consumer groups of the event hub.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     listEventHubConsumerGroups,
+				Transform:   transform.FromValue(),
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+			{
+				Name:        "akas",
+				Description: ColumnDescriptionAkas,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("ID").Transform(idToAkas),
+			},
+
+			// Azure standard columns
+			{
+				Name:        "region",
+				Description: ColumnDescriptionRegion,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Location").Transform(toLower),
+			},
+			{
+				Name:        "resource_group",
+				Description: ColumnDescriptionResourceGroup,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ID").Transform(extractResourceGroupFromID),
+			},
+		}),
+	}
+}
+
+type EventHubInfo = struct {
+	eventhub.Model
+	NamespaceName *string
+	Location      *string
+}
+
+//// LIST FUNCTION
+
+func listEventHubs(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	// Get the details of event hub namespace
+	namespace := h.Item.(eventhub.EHNamespace)
+	resourceGroup := strings.Split(*namespace.ID, "/")[4]
+
+	session, err := GetNewSession(ctx, d, "MANAGEMENT")
+	if err != nil {
+		return nil, err
+	}
+	subscriptionID := session.SubscriptionID
+
+	client := eventhub.NewEventHubsClientWithBaseURI(session.ResourceManagerEndpoint, subscriptionID)
+	client.Authorizer = session.Authorizer
+
+	result, err := client.ListByNamespace(ctx, resourceGroup, *namespace.Name, nil, nil)
+	if err != nil {
+		plugin.Logger(ctx).Error("listEventHubs", "list", err)
+		return nil, err
+	}
+
+	for _, eventHub := range result.Values() {
+		d.StreamListItem(ctx, EventHubInfo{eventHub, namespace.Name, namespace.Location})
+		// Check if context has been cancelled or if the limit has been hit (if specified)
+		// if there is a limit, it will return the number of rows required to reach this limit
+		if d.RowsRemaining(ctx) == 0 {
+			return nil, nil
+		}
+	}
+
+	for result.NotDone() {
+		err = result.NextWithContext(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("listEventHubs", "list_paging", err)
+			return nil, err
+		}
+		for _, eventHub := range result.Values() {
+			d.StreamListItem(ctx, EventHubInfo{eventHub, namespace.Name, namespace.Location})
+			// Check if context has been cancelled or if the limit has been hit (if specified)
+			// if there is a limit, it will return the number of rows required to reach this limit
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, err
+}
+
+//// HYDRATE FUNCTIONS
+
+func getEventHub(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("getEventHub")
+
+	namespaceName := d.EqualsQuals["namespace_name"].GetStringValue()
+	name := d.EqualsQuals["name"].GetStringValue()
+	resourceGroup := d.EqualsQuals["resource_group"].GetStringValue()
+
+	// Handle empty check
+	if namespaceName == "" || name == "" || resourceGroup == "" {
+		return nil, nil
+	}
+
+	session, err := GetNewSession(ctx, d, "MANAGEMENT")
+	if err != nil {
+		return nil, err
+	}
+	subscriptionID := session.SubscriptionID
+
+	client := eventhub.NewEventHubsClientWithBaseURI(session.ResourceManagerEndpoint, subscriptionID)
+	client.Authorizer = session.Authorizer
+
+	op, err := client.Get(ctx, resourceGroup, namespaceName, name)
+	if err != nil {
+		plugin.Logger(ctx).Error("getEventHub", "get", err)
+		return nil, err
+	}
+
+	// In some cases resource does not give any notFound error
+	// instead of notFound error, it returns empty data
+	if op.ID == nil {
+		return nil, nil
+	}
+
+	// The event hub does not return the location, so it is taken from the namespace
+	namespaceClient := eventhub.NewNamespacesClientWithBaseURI(session.ResourceManagerEndpoint, subscriptionID)
+	namespaceClient.Authorizer = session.Authorizer
+
+	namespace, err := namespaceClient.Get(ctx, resourceGroup, namespaceName)
+	if err != nil {
+		plugin.Logger(ctx).Error("getEventHub", "get_namespace", err)
+		return nil, err
+	}
+
+	return EventHubInfo{op, namespace.Name, namespace.Location}, nil
+}
+
+func listEventHubConsumerGroups(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	eventHub := h.Item.(EventHubInfo)
+	resourceGroup := strings.Split(*eventHub.ID, "/")[4]
+
+	session, err := GetNewSession(ctx, d, "MANAGEMENT")
+	if err != nil {
+		return nil, err
+	}
+	subscriptionID := session.SubscriptionID
+
+	client := eventhub.NewConsumerGroupsClientWithBaseURI(session.ResourceManagerEndpoint, subscriptionID)
+	client.Authorizer = session.Authorizer
+
+	result, err := client.ListByEventHub(ctx, resourceGroup, *eventHub.NamespaceName, *eventHub.Name, nil, nil)
+	if err != nil {
+		plugin.Logger(ctx).Error("listEventHubConsumerGroups", "list", err)
+		return nil, err
+	}
+
+	var consumerGroups []map[string]interface{}
+	for _, consumerGroup := range result.Values() {
+		consumerGroups = append(consumerGroups, extractEventHubConsumerGroup(consumerGroup))
+	}
+
+	for result.NotDone() {
+		err = result.NextWithContext(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("listEventHubConsumerGroups", "list_paging", err)
+			return nil, err
+		}
+		for _, consumerGroup := range result.Values() {
+			consumerGroups = append(consumerGroups, extractEventHubConsumerGroup(consumerGroup))
+		}
+	}
+
+	return consumerGroups, nil
+}
+
+//// UTILITY FUNCTIONS
+
+// If we return the API response directly, the output will not provide the read-only properties of ConsumerGroupProperties
+func extractEventHubConsumerGroup(i eventhub.ConsumerGroup) map[string]interface{} {
+	consumerGroup := make(map[string]interface{})
+	if i.ID != nil {
+		consumerGroup["id"] = *i.ID
+	}
+	if i.Name != nil {
+		consumerGroup["name"] = *i.Name
+	}
+	if i.Type != nil {
+		consumerGroup["type"] = *i.Type
+	}
+	if i.ConsumerGroupProperties != nil {
+		properties := make(map[string]interface{})
+		if i.CreatedAt != nil {
+			properties["createdAt"] = i.CreatedAt
+		}
+		if i.UpdatedAt != nil {
+			properties["updatedAt"] = i.UpdatedAt
+		}
+		if i.UserMetadata != nil {
+			properties["userMetadata"] = *i.UserMetadata
+		}
+		consumerGroup["properties"] = properties
+	}
+	return consumerGroup
+}
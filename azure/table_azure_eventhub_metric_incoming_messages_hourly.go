@@ -0,0 +1,58 @@
+package azure
+
+import (
+	"context"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/profiles/preview/preview/eventhub/mgmt/eventhub"
+	"github.com/turbot/go-kit/types"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAzureEventHubMetricIncomingMessagesHourly(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "azure_eventhub_metric_incoming_messages_hourly",
+		Description: "Azure Event Hub Metrics - Incoming Messages (Hourly)",
+		List: &plugin.ListConfig{
+			ParentHydrate: listEventHubNamespaces,
+			Hydrate:       listEventHubMetricIncomingMessagesHourly,
+		},
+		Columns: monitoringMetricColumns([]*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name of the event hub.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("DimensionValue").Transform(lastPathElement),
+			},
+			{
+				Name:        "namespace_name",
+				Description: "The name of the Event Hub namespace the event hub belongs to.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("DimensionValue").Transform(extractEventHubNamespaceFromMetricDimension),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listEventHubMetricIncomingMessagesHourly(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	namespaceInfo := h.Item.(eventhub.EHNamespace)
+
+	return listAzureMonitorMetricStatisticsByDimension(ctx, d, "HOURLY", "Microsoft.EventHub/namespaces", "IncomingMessages", *namespaceInfo.ID, "EntityName", "eventhubs")
+}
+
+//// TRANSFORM FUNCTIONS
+
+// The dimension value has the form <namespace ID>/eventhubs/<event hub name>
+func extractEventHubNamespaceFromMetricDimension(ctx context.Context, d *transform.TransformData) (interface{}, error) {
+	splitID := strings.Split(types.SafeString(d.Value), "/")
+	if len(splitID) < 3 {
+		return nil, nil
+	}
+	return splitID[len(splitID)-3], nil
+}
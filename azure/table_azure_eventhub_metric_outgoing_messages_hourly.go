@@ -0,0 +1,45 @@
+package azure
+
+import (
+	"context"
+
+	"github.com/Azure/azure-sdk-for-go/profiles/preview/preview/eventhub/mgmt/eventhub"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAzureEventHubMetricOutgoingMessagesHourly(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "azure_eventhub_metric_outgoing_messages_hourly",
+		Description: "Azure Event Hub Metrics - Outgoing Messages (Hourly)",
+		List: &plugin.ListConfig{
+			ParentHydrate: listEventHubNamespaces,
+			Hydrate:       listEventHubMetricOutgoingMessagesHourly,
+		},
+		Columns: monitoringMetricColumns([]*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name of the event hub.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("DimensionValue").Transform(lastPathElement),
+			},
+			{
+				Name:        "namespace_name",
+				Description: "The name of the Event Hub namespace the event hub belongs to.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("DimensionValue").Transform(extractEventHubNamespaceFromMetricDimension),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listEventHubMetricOutgoingMessagesHourly(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	namespaceInfo := h.Item.(eventhub.EHNamespace)
+
+	return listAzureMonitorMetricStatisticsByDimension(ctx, d, "HOURLY", "Microsoft.EventHub/namespaces", "OutgoingMessages", *namespaceInfo.ID, "EntityName", "eventhubs")
+}
@@ -27,6 +27,13 @@ func tableAzureEventHubNamespace(_ context.Context) *plugin.Table {
 		List: &plugin.ListConfig{
 			Hydrate: listEventHubNamespaces,
 		},
+		HydrateConfig: []plugin.HydrateConfig{
+			{
+				Func:           listEventHubNamespaceDiagnosticSettings,
+				MaxConcurrency: diagnosticSettingsMaxConcurrency,
+				Tags:           map[string]string{"service": "Microsoft.Insights", "action": "diagnosticSettings/list"},
+			},
+		},
 		Columns: azureColumns([]*plugin.Column{
 			{
 				Name:        "name",
@@ -84,7 +91,7 @@ func tableAzureEventHubNamespace(_ context.Context) *plugin.Table {
 				Name:        "metric_id",
 				Description: "Identifier for azure insights metrics.",
 				Type:        proto.ColumnType_STRING,
-				Transform:   transform.FromField("EHNamespaceProperties.Metric_id"),
+				Transform:   transform.FromField("EHNamespaceProperties.MetricID"),
 			},
 			{
 				Name:        "service_bus_endpoint",
@@ -137,9 +144,9 @@ func tableAzureEventHubNamespace(_ context.Context) *plugin.Table {
 			},
 			{
 				Name:        "identity",
-				Description: "Describes the properties of BYOK encryption description.",
+				Description: "The managed service identity of the namespace, including the identity type, principal ID, tenant ID and user assigned identities.",
 				Type:        proto.ColumnType_JSON,
-				Transform:   transform.FromField("EHNamespaceProperties.Encryption"),
+				Transform:   transform.FromField("Identity"),
 			},
 			{
 				Name:        "network_rule_set",
@@ -132,6 +132,12 @@ func tableAzureFirewall(_ context.Context) *plugin.Table {
 				Type:        proto.ColumnType_JSON,
 				Transform:   transform.FromField("AzureFirewallPropertiesFormat.IPGroups"),
 			},
+			{
+				Name:        "management_ip_configuration",
+				Description: "IP configuration of the Azure Firewall used for management traffic",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.From(managementIPConfigurationData),
+			},
 			{
 				Name:        "nat_rule_collections",
 				Description: "A collection of NAT rule collections used by Azure Firewall",
@@ -284,3 +290,32 @@ func ipConfigurationData(ctx context.Context, d *transform.TransformData) (inter
 	}
 	return nil, nil
 }
+
+func managementIPConfigurationData(ctx context.Context, d *transform.TransformData) (interface{}, error) {
+	data := d.HydrateItem.(network.AzureFirewall)
+
+	// Add a check for ManagementIPConfiguration data to ensure that
+	// it is not null to avoid panic errors
+	if data.AzureFirewallPropertiesFormat == nil || data.AzureFirewallPropertiesFormat.ManagementIPConfiguration == nil {
+		return nil, nil
+	}
+	config := data.AzureFirewallPropertiesFormat.ManagementIPConfiguration
+	if config.AzureFirewallIPConfigurationPropertiesFormat == nil {
+		return nil, nil
+	}
+
+	objectMap := make(map[string]interface{})
+	if config.AzureFirewallIPConfigurationPropertiesFormat.PrivateIPAddress != nil {
+		objectMap["privateIPAddress"] = config.AzureFirewallIPConfigurationPropertiesFormat.PrivateIPAddress
+	}
+	if config.AzureFirewallIPConfigurationPropertiesFormat.PublicIPAddress != nil {
+		objectMap["publicIPAddress"] = config.AzureFirewallIPConfigurationPropertiesFormat.PublicIPAddress
+	}
+	if config.AzureFirewallIPConfigurationPropertiesFormat.Subnet != nil {
+		objectMap["subnet"] = config.AzureFirewallIPConfigurationPropertiesFormat.Subnet
+	}
+	if config.AzureFirewallIPConfigurationPropertiesFormat.ProvisioningState != "" {
+		objectMap["provisioningState"] = config.AzureFirewallIPConfigurationPropertiesFormat.ProvisioningState
+	}
+	return objectMap, nil
+}
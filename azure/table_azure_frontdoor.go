@@ -27,6 +27,13 @@ func tableAzureFrontDoor(_ context.Context) *plugin.Table {
 		List: &plugin.ListConfig{
 			Hydrate: listFrontDoors,
 		},
+		HydrateConfig: []plugin.HydrateConfig{
+			{
+				Func:           listFrontDoorDiagnosticSettings,
+				MaxConcurrency: diagnosticSettingsMaxConcurrency,
+				Tags:           map[string]string{"service": "Microsoft.Insights", "action": "diagnosticSettings/list"},
+			},
+		},
 		Columns: azureColumns([]*plugin.Column{
 			{
 				Name:        "name",
@@ -0,0 +1,281 @@
+package azure
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/profiles/latest/resources/mgmt/resources"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/policy"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+)
+
+const (
+	// The Azure SDK version used by the plugin does not provide a client for
+	// Azure Managed Grafana workspaces, so they are read as generic resources
+	grafanaWorkspaceAPIVersion = "2023-09-01"
+
+	// Scope of the Azure Managed Grafana application, used to get a token
+	// for the Grafana HTTP API of a workspace
+	grafanaDataPlaneScope = "ce34e7e5-485f-4d76-964f-b3d2b16d1e4f/.default"
+
+	// Maximum number of results the Grafana search API returns per page
+	grafanaSearchPageLimit = 1000
+)
+
+//// TABLE DEFINITION
+
+func tableAzureGrafanaDashboard(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "azure_grafana_dashboard",
+		Description: "Azure Managed Grafana Dashboard",
+		List: &plugin.ListConfig{
+			KeyColumns: plugin.AllColumns([]string{"workspace_name", "resource_group"}),
+			Hydrate:    listGrafanaDashboards,
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: isNotFoundError([]string{"ResourceNotFound", "ResourceGroupNotFound", "404"}),
+			},
+		},
+		Columns: azureColumns([]*plugin.Column{
+			{
+				Name:        "uid",
+				Description: "The unique identifier of the dashboard within the workspace.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("UID"),
+			},
+			{
+				Name:        "title",
+				Description: "The title of the dashboard.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "workspace_name",
+				Description: "The name of the Azure Managed Grafana workspace the dashboard belongs to.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "dashboard_id",
+				Description: "The numeric ID of the dashboard within the workspace.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("ID"),
+			},
+			{
+				Name:        "uri",
+				Description: "The URI of the dashboard, e.g. 'db/my-dashboard'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("URI"),
+			},
+			{
+				Name:        "url",
+				Description: "The URL path of the dashboard within the workspace.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("URL"),
+			},
+			{
+				Name:        "slug",
+				Description: "The slug of the dashboard.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "type",
+				Description: "The type of the search result, which is always 'dash-db' for dashboards.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "is_starred",
+				Description: "Indicates whether the dashboard is starred by the identity used to query the workspace.",
+				Type:        proto.ColumnType_BOOL,
+			},
+			{
+				Name:        "folder_id",
+				Description: "The numeric ID of the folder containing the dashboard.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("FolderID"),
+			},
+			{
+				Name:        "folder_uid",
+				Description: "The unique identifier of the folder containing the dashboard.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("FolderUID"),
+			},
+			{
+				Name:        "folder_title",
+				Description: "The title of the folder containing the dashboard.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "folder_url",
+				Description: "The URL path of the folder containing the dashboard.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("FolderURL"),
+			},
+			{
+				Name:        "sort_meta",
+				Description: "The sort metadata of the search result.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "tags",
+				Description: "The tags of the dashboard.",
+				Type:        proto.ColumnType_JSON,
+			},
+
+			// Azure standard columns
+			{
+				Name:        "resource_group",
+				Description: ColumnDescriptionResourceGroup,
+				Type:        proto.ColumnType_STRING,
+			},
+		}),
+	}
+}
+
+// GrafanaDashboard is a dashboard as returned by the Grafana search API
+type GrafanaDashboard struct {
+	ID          int64    `json:"id"`
+	UID         string   `json:"uid"`
+	Title       string   `json:"title"`
+	URI         string   `json:"uri"`
+	URL         string   `json:"url"`
+	Slug        string   `json:"slug"`
+	Type        string   `json:"type"`
+	Tags        []string `json:"tags"`
+	IsStarred   bool     `json:"isStarred"`
+	FolderID    int64    `json:"folderId"`
+	FolderUID   string   `json:"folderUid"`
+	FolderTitle string   `json:"folderTitle"`
+	FolderURL   string   `json:"folderUrl"`
+	SortMeta    int64    `json:"sortMeta"`
+}
+
+type GrafanaDashboardInfo = struct {
+	GrafanaDashboard
+	WorkspaceName string
+	ResourceGroup string
+}
+
+//// LIST FUNCTION
+
+func listGrafanaDashboards(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	workspaceName := d.EqualsQuals["workspace_name"].GetStringValue()
+	resourceGroup := d.EqualsQuals["resource_group"].GetStringValue()
+
+	// Handle empty workspaceName or resourceGroup
+	if workspaceName == "" || resourceGroup == "" {
+		return nil, nil
+	}
+
+	endpoint, err := getGrafanaWorkspaceEndpoint(ctx, d, resourceGroup, workspaceName)
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_grafana_dashboard.listGrafanaDashboards", "get_workspace_error", err)
+		return nil, err
+	}
+	if endpoint == "" {
+		return nil, nil
+	}
+
+	session, err := GetNewSessionUpdated(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_grafana_dashboard.listGrafanaDashboards", "session_error", err)
+		return nil, err
+	}
+
+	token, err := session.Cred.GetToken(ctx, policy.TokenRequestOptions{Scopes: []string{grafanaDataPlaneScope}})
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_grafana_dashboard.listGrafanaDashboards", "token_error", err)
+		return nil, err
+	}
+
+	for page := 1; ; page++ {
+		dashboards, err := searchGrafanaDashboards(ctx, endpoint, token.Token, page)
+		if err != nil {
+			plugin.Logger(ctx).Error("azure_grafana_dashboard.listGrafanaDashboards", "api_error", err)
+			return nil, err
+		}
+
+		for _, dashboard := range dashboards {
+			d.StreamListItem(ctx, GrafanaDashboardInfo{dashboard, workspaceName, resourceGroup})
+			// Check if context has been cancelled or if the limit has been hit (if specified)
+			// if there is a limit, it will return the number of rows required to reach this limit
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+
+		if len(dashboards) < grafanaSearchPageLimit {
+			break
+		}
+	}
+
+	return nil, nil
+}
+
+//// UTILITY FUNCTIONS
+
+// getGrafanaWorkspaceEndpoint returns the URL of the Grafana HTTP API of a workspace
+func getGrafanaWorkspaceEndpoint(ctx context.Context, d *plugin.QueryData, resourceGroup string, workspaceName string) (string, error) {
+	session, err := GetNewSession(ctx, d, "MANAGEMENT")
+	if err != nil {
+		return "", err
+	}
+	subscriptionID := session.SubscriptionID
+
+	client := resources.NewClientWithBaseURI(session.ResourceManagerEndpoint, subscriptionID)
+	client.Authorizer = session.Authorizer
+
+	workspace, err := client.Get(ctx, resourceGroup, "Microsoft.Dashboard", "", "grafana", workspaceName, grafanaWorkspaceAPIVersion)
+	if err != nil {
+		return "", err
+	}
+
+	properties, ok := workspace.Properties.(map[string]interface{})
+	if !ok {
+		return "", nil
+	}
+	endpoint, _ := properties["endpoint"].(string)
+
+	return strings.TrimSuffix(endpoint, "/"), nil
+}
+
+// searchGrafanaDashboards returns a page of the dashboards of a workspace from the Grafana search API
+func searchGrafanaDashboards(ctx context.Context, endpoint string, token string, page int) ([]GrafanaDashboard, error) {
+	query := url.Values{}
+	query.Set("type", "dash-db")
+	query.Set("limit", fmt.Sprint(grafanaSearchPageLimit))
+	query.Set("page", fmt.Sprint(page))
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint+"/api/search?"+query.Encode(), nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Authorization", "Bearer "+token)
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("grafana search API returned status %d: %s", resp.StatusCode, string(body))
+	}
+
+	var dashboards []GrafanaDashboard
+	if err := json.Unmarshal(body, &dashboards); err != nil {
+		return nil, err
+	}
+
+	return dashboards, nil
+}
@@ -27,6 +27,13 @@ func tableAzureHDInsightCluster(_ context.Context) *plugin.Table {
 		List: &plugin.ListConfig{
 			Hydrate: listHDInsightClusters,
 		},
+		HydrateConfig: []plugin.HydrateConfig{
+			{
+				Func:           listHDInsightClusterDiagnosticSettings,
+				MaxConcurrency: diagnosticSettingsMaxConcurrency,
+				Tags:           map[string]string{"service": "Microsoft.Insights", "action": "diagnosticSettings/list"},
+			},
+		},
 		Columns: azureColumns([]*plugin.Column{
 			{
 				Name:        "name",
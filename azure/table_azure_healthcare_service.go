@@ -29,6 +29,13 @@ func tableAzureHealthcareService(_ context.Context) *plugin.Table {
 		List: &plugin.ListConfig{
 			Hydrate: listHealthcareServices,
 		},
+		HydrateConfig: []plugin.HydrateConfig{
+			{
+				Func:           getHealthcareServiceDignosticSettings,
+				MaxConcurrency: diagnosticSettingsMaxConcurrency,
+				Tags:           map[string]string{"service": "Microsoft.Insights", "action": "diagnosticSettings/list"},
+			},
+		},
 		Columns: azureColumns([]*plugin.Column{
 			{
 				Name:        "name",
@@ -28,6 +28,13 @@ func tableAzureIotHub(_ context.Context) *plugin.Table {
 		List: &plugin.ListConfig{
 			Hydrate: listIotHubs,
 		},
+		HydrateConfig: []plugin.HydrateConfig{
+			{
+				Func:           listIotHubDiagnosticSettings,
+				MaxConcurrency: diagnosticSettingsMaxConcurrency,
+				Tags:           map[string]string{"service": "Microsoft.Insights", "action": "diagnosticSettings/list"},
+			},
+		},
 		Columns: azureColumns([]*plugin.Column{
 			{
 				Name:        "name",
@@ -27,6 +27,13 @@ func tableAzureIotHubDps(_ context.Context) *plugin.Table {
 		List: &plugin.ListConfig{
 			Hydrate: listIotHubDpses,
 		},
+		HydrateConfig: []plugin.HydrateConfig{
+			{
+				Func:           listIotDpsDiagnosticSettings,
+				MaxConcurrency: diagnosticSettingsMaxConcurrency,
+				Tags:           map[string]string{"service": "Microsoft.Insights", "action": "diagnosticSettings/list"},
+			},
+		},
 		Columns: azureColumns([]*plugin.Column{
 			{
 				Name:        "name",
@@ -28,6 +28,13 @@ func tableAzureKeyVault(_ context.Context) *plugin.Table {
 		List: &plugin.ListConfig{
 			Hydrate: listKeyVaults,
 		},
+		HydrateConfig: []plugin.HydrateConfig{
+			{
+				Func:           listKmsKeyVaultDiagnosticSettings,
+				MaxConcurrency: diagnosticSettingsMaxConcurrency,
+				Tags:           map[string]string{"service": "Microsoft.Insights", "action": "diagnosticSettings/list"},
+			},
+		},
 		Columns: azureColumns([]*plugin.Column{
 			{
 				Name:        "name",
@@ -0,0 +1,140 @@
+package azure
+
+import (
+	"context"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/profiles/latest/keyvault/mgmt/keyvault"
+	certificate "github.com/Azure/azure-sdk-for-go/services/keyvault/v7.1/keyvault"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+)
+
+//// TABLE DEFINITION
+
+func tableAzureKeyVaultCertificateContact(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "azure_key_vault_certificate_contact",
+		Description: "Azure Key Vault Certificate Contact",
+		List: &plugin.ListConfig{
+			KeyColumns: plugin.KeyColumnSlice{
+				{
+					Name: "vault_name", Require: plugin.Optional,
+				},
+			},
+			ParentHydrate: listKeyVaults,
+			Hydrate:       listKeyVaultCertificateContacts,
+		},
+		Columns: azureColumns([]*plugin.Column{
+			{
+				Name:        "email",
+				Description: "The email address of the contact.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Contact.EmailAddress"),
+			},
+			{
+				Name:        "name",
+				Description: "The name of the contact.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Contact.Name"),
+			},
+			{
+				Name:        "phone",
+				Description: "The phone number of the contact.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Contact.Phone"),
+			},
+			{
+				Name:        "vault_name",
+				Description: "The name of the key vault the contact is configured in.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "vault_uri",
+				Description: "The URI of the key vault the contact is configured in.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("VaultURI"),
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Contact.EmailAddress"),
+			},
+
+			// Azure standard columns
+			{
+				Name:        "region",
+				Description: ColumnDescriptionRegion,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Location").Transform(toLower),
+			},
+			{
+				Name:        "resource_group",
+				Description: ColumnDescriptionResourceGroup,
+				Type:        proto.ColumnType_STRING,
+			},
+		}),
+	}
+}
+
+type KeyVaultCertificateContactInfo = struct {
+	Contact       certificate.Contact
+	VaultName     *string
+	VaultURI      string
+	ResourceGroup string
+	Location      *string
+}
+
+//// LIST FUNCTION
+
+func listKeyVaultCertificateContacts(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	// Get the details of key vault
+	vault := h.Item.(keyvault.Resource)
+
+	// Validate is hydrate vault name matches the user provided vault name
+	if d.EqualsQuals["vault_name"] != nil && d.EqualsQualString("vault_name") != *vault.Name {
+		return nil, nil
+	}
+
+	// Create session
+	session, err := GetNewSession(ctx, d, "VAULT")
+	if err != nil {
+		return nil, err
+	}
+
+	vaultURI := "https://" + *vault.Name + ".vault.azure.net/"
+	resourceGroup := strings.Split(*vault.ID, "/")[4]
+
+	client := certificate.New()
+	client.Authorizer = session.Authorizer
+
+	result, err := client.GetCertificateContacts(ctx, vaultURI)
+	if err != nil {
+		// The API returns a not found error if no contact is configured in the vault
+		if strings.Contains(err.Error(), "404") || strings.Contains(err.Error(), "ContactsNotFound") {
+			return nil, nil
+		}
+		plugin.Logger(ctx).Error("azure_key_vault_certificate_contact.listKeyVaultCertificateContacts", "api_error", err)
+		return nil, err
+	}
+
+	if result.ContactList == nil {
+		return nil, nil
+	}
+
+	for _, contact := range *result.ContactList {
+		d.StreamLeafListItem(ctx, KeyVaultCertificateContactInfo{contact, vault.Name, vaultURI, resourceGroup, vault.Location})
+		// Check if context has been cancelled or if the limit has been hit (if specified)
+		// if there is a limit, it will return the number of rows required to reach this limit
+		if d.RowsRemaining(ctx) == 0 {
+			return nil, nil
+		}
+	}
+
+	return nil, nil
+}
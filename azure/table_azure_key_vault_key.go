@@ -133,6 +133,13 @@ func tableAzureKeyVaultKey(_ context.Context) *plugin.Table {
 				Hydrate:     getKeyVaultKey,
 				Transform:   transform.FromField("KeyProperties.KeyOps"),
 			},
+			{
+				Name:        "rotation_policy",
+				Description: "The rotation policy of the key, with the lifetime actions that rotate the key or notify before its expiry.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getKeyVaultKey,
+				Transform:   transform.FromField("KeyProperties.RotationPolicy"),
+			},
 
 			// Steampipe standard columns
 			{
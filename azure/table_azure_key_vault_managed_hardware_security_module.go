@@ -28,6 +28,13 @@ func tableAzureKeyVaultManagedHardwareSecurityModule(_ context.Context) *plugin.
 		List: &plugin.ListConfig{
 			Hydrate: listKeyVaultManagedHardwareSecurityModules,
 		},
+		HydrateConfig: []plugin.HydrateConfig{
+			{
+				Func:           listKeyVaultHsmDiagnosticSettings,
+				MaxConcurrency: diagnosticSettingsMaxConcurrency,
+				Tags:           map[string]string{"service": "Microsoft.Insights", "action": "diagnosticSettings/list"},
+			},
+		},
 		Columns: azureColumns([]*plugin.Column{
 			{
 				Name:        "name",
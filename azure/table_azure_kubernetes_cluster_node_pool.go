@@ -0,0 +1,342 @@
+package azure
+
+import (
+	"context"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/profiles/latest/containerservice/mgmt/containerservice"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+type KubernetesClusterNodePoolInfo = struct {
+	containerservice.AgentPool
+	ClusterName *string
+	Location    *string
+}
+
+//// TABLE DEFINITION
+
+func tableAzureKubernetesClusterNodePool(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "azure_kubernetes_cluster_node_pool",
+		Description: "Azure Kubernetes Cluster Node Pool",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.AllColumns([]string{"cluster_name", "name", "resource_group"}),
+			Hydrate:    getKubernetesClusterNodePool,
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: isNotFoundError([]string{"ResourceNotFound", "ResourceGroupNotFound", "404"}),
+			},
+		},
+		List: &plugin.ListConfig{
+			ParentHydrate: listKubernetesClusters,
+			Hydrate:       listKubernetesClusterNodePools,
+		},
+		Columns: azureColumns([]*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name of the node pool.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "id",
+				Description: "The ID of the node pool.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromGo(),
+			},
+			{
+				Name:        "type",
+				Description: "The type of the resource.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "cluster_name",
+				Description: "The name of the cluster the node pool belongs to.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "provisioning_state",
+				Description: "The provisioning state of the node pool.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ManagedClusterAgentPoolProfileProperties.ProvisioningState"),
+			},
+			{
+				Name:        "power_state",
+				Description: "The power state of the node pool. Possible values include: 'Running', 'Stopped'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ManagedClusterAgentPoolProfileProperties.PowerState.Code"),
+			},
+			{
+				Name:        "mode",
+				Description: "The mode of the node pool. A cluster must have at least one System node pool. Possible values include: 'System', 'User'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ManagedClusterAgentPoolProfileProperties.Mode"),
+			},
+			{
+				Name:        "vm_size",
+				Description: "The size of the virtual machines of the node pool.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ManagedClusterAgentPoolProfileProperties.VMSize"),
+			},
+			{
+				Name:        "os_type",
+				Description: "The operating system type of the nodes. Possible values include: 'Linux', 'Windows'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ManagedClusterAgentPoolProfileProperties.OsType"),
+			},
+			{
+				Name:        "os_sku",
+				Description: "The operating system SKU of the nodes. Possible values include: 'Ubuntu', 'CBLMariner', 'Windows2019', 'Windows2022'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ManagedClusterAgentPoolProfileProperties.OsSKU"),
+			},
+			{
+				Name:        "os_disk_size_gb",
+				Description: "The OS disk size of the nodes, in GB.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("ManagedClusterAgentPoolProfileProperties.OsDiskSizeGB"),
+			},
+			{
+				Name:        "os_disk_type",
+				Description: "The OS disk type of the nodes. Possible values include: 'Managed', 'Ephemeral'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ManagedClusterAgentPoolProfileProperties.OsDiskType"),
+			},
+			{
+				Name:        "count",
+				Description: "The number of nodes of the node pool.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("ManagedClusterAgentPoolProfileProperties.Count"),
+			},
+			{
+				Name:        "min_count",
+				Description: "The minimum number of nodes for auto-scaling.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("ManagedClusterAgentPoolProfileProperties.MinCount"),
+			},
+			{
+				Name:        "max_count",
+				Description: "The maximum number of nodes for auto-scaling.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("ManagedClusterAgentPoolProfileProperties.MaxCount"),
+			},
+			{
+				Name:        "enable_auto_scaling",
+				Description: "Indicates whether the auto-scaler is enabled for the node pool.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("ManagedClusterAgentPoolProfileProperties.EnableAutoScaling"),
+			},
+			{
+				Name:        "max_pods",
+				Description: "The maximum number of pods that can run on a node.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("ManagedClusterAgentPoolProfileProperties.MaxPods"),
+			},
+			{
+				Name:        "orchestrator_version",
+				Description: "The Kubernetes version of the node pool.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ManagedClusterAgentPoolProfileProperties.OrchestratorVersion"),
+			},
+			{
+				Name:        "current_orchestrator_version",
+				Description: "The Kubernetes version the node pool is running.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ManagedClusterAgentPoolProfileProperties.CurrentOrchestratorVersion"),
+			},
+			{
+				Name:        "node_image_version",
+				Description: "The version of the node image.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ManagedClusterAgentPoolProfileProperties.NodeImageVersion"),
+			},
+			{
+				Name:        "scale_set_priority",
+				Description: "The virtual machine scale set priority of the node pool. Possible values include: 'Spot', 'Regular'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ManagedClusterAgentPoolProfileProperties.ScaleSetPriority"),
+			},
+			{
+				Name:        "enable_node_public_ip",
+				Description: "Indicates whether each node is allocated its own public IP.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("ManagedClusterAgentPoolProfileProperties.EnableNodePublicIP"),
+			},
+			{
+				Name:        "enable_encryption_at_host",
+				Description: "Indicates whether host based OS and data drive encryption is enabled.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("ManagedClusterAgentPoolProfileProperties.EnableEncryptionAtHost"),
+			},
+			{
+				Name:        "enable_fips",
+				Description: "Indicates whether the nodes use a FIPS-enabled OS.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("ManagedClusterAgentPoolProfileProperties.EnableFIPS"),
+			},
+			{
+				Name:        "vnet_subnet_id",
+				Description: "The ID of the subnet the nodes join.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ManagedClusterAgentPoolProfileProperties.VnetSubnetID"),
+			},
+			{
+				Name:        "availability_zones",
+				Description: "The list of availability zones of the nodes.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("ManagedClusterAgentPoolProfileProperties.AvailabilityZones"),
+			},
+			{
+				Name:        "node_labels",
+				Description: "The node labels to be persisted across all nodes of the node pool.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("ManagedClusterAgentPoolProfileProperties.NodeLabels"),
+			},
+			{
+				Name:        "node_taints",
+				Description: "The taints added to new nodes during node pool create and scale, e.g. key=value:NoSchedule.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("ManagedClusterAgentPoolProfileProperties.NodeTaints"),
+			},
+			{
+				Name:        "upgrade_settings",
+				Description: "The settings for upgrading the node pool.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("ManagedClusterAgentPoolProfileProperties.UpgradeSettings"),
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+			{
+				Name:        "tags",
+				Description: ColumnDescriptionTags,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("ManagedClusterAgentPoolProfileProperties.Tags"),
+			},
+			{
+				Name:        "akas",
+				Description: ColumnDescriptionAkas,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("ID").Transform(idToAkas),
+			},
+
+			// Azure standard columns
+			{
+				Name:        "region",
+				Description: ColumnDescriptionRegion,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Location").Transform(toLower),
+			},
+			{
+				Name:        "resource_group",
+				Description: ColumnDescriptionResourceGroup,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ID").Transform(extractResourceGroupFromID),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listKubernetesClusterNodePools(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	cluster := h.Item.(containerservice.ManagedCluster)
+	resourceGroup := strings.Split(*cluster.ID, "/")[4]
+
+	session, err := GetNewSession(ctx, d, "MANAGEMENT")
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_kubernetes_cluster_node_pool.listKubernetesClusterNodePools", "session_error", err)
+		return nil, err
+	}
+	subscriptionID := session.SubscriptionID
+
+	client := containerservice.NewAgentPoolsClientWithBaseURI(session.ResourceManagerEndpoint, subscriptionID)
+	client.Authorizer = session.Authorizer
+
+	result, err := client.List(ctx, resourceGroup, *cluster.Name)
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_kubernetes_cluster_node_pool.listKubernetesClusterNodePools", "api_error", err)
+		return nil, err
+	}
+
+	for _, pool := range result.Values() {
+		d.StreamListItem(ctx, KubernetesClusterNodePoolInfo{pool, cluster.Name, cluster.Location})
+		// Check if context has been cancelled or if the limit has been hit (if specified)
+		// if there is a limit, it will return the number of rows required to reach this limit
+		if d.RowsRemaining(ctx) == 0 {
+			return nil, nil
+		}
+	}
+
+	for result.NotDone() {
+		err = result.NextWithContext(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("azure_kubernetes_cluster_node_pool.listKubernetesClusterNodePools", "api_paging_error", err)
+			return nil, err
+		}
+
+		for _, pool := range result.Values() {
+			d.StreamListItem(ctx, KubernetesClusterNodePoolInfo{pool, cluster.Name, cluster.Location})
+			// Check if context has been cancelled or if the limit has been hit (if specified)
+			// if there is a limit, it will return the number of rows required to reach this limit
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getKubernetesClusterNodePool(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	clusterName := d.EqualsQualString("cluster_name")
+	name := d.EqualsQualString("name")
+	resourceGroup := d.EqualsQualString("resource_group")
+
+	// Handle empty cluster_name, name or resource_group
+	if clusterName == "" || name == "" || resourceGroup == "" {
+		return nil, nil
+	}
+
+	session, err := GetNewSession(ctx, d, "MANAGEMENT")
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_kubernetes_cluster_node_pool.getKubernetesClusterNodePool", "session_error", err)
+		return nil, err
+	}
+	subscriptionID := session.SubscriptionID
+
+	// The node pool does not return the location, so it is taken from the cluster
+	clusterClient := containerservice.NewManagedClustersClientWithBaseURI(session.ResourceManagerEndpoint, subscriptionID)
+	clusterClient.Authorizer = session.Authorizer
+
+	cluster, err := clusterClient.Get(ctx, resourceGroup, clusterName)
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_kubernetes_cluster_node_pool.getKubernetesClusterNodePool", "get_cluster_error", err)
+		return nil, err
+	}
+
+	client := containerservice.NewAgentPoolsClientWithBaseURI(session.ResourceManagerEndpoint, subscriptionID)
+	client.Authorizer = session.Authorizer
+
+	op, err := client.Get(ctx, resourceGroup, clusterName, name)
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_kubernetes_cluster_node_pool.getKubernetesClusterNodePool", "api_error", err)
+		return nil, err
+	}
+
+	// In some cases resource does not give any notFound error
+	// instead of notFound error, it returns empty data
+	if op.ID != nil {
+		return KubernetesClusterNodePoolInfo{op, cluster.Name, cluster.Location}, nil
+	}
+
+	return nil, nil
+}
@@ -27,6 +27,13 @@ func tableAzureLoadBalancer(_ context.Context) *plugin.Table {
 		List: &plugin.ListConfig{
 			Hydrate: listLoadBalancers,
 		},
+		HydrateConfig: []plugin.HydrateConfig{
+			{
+				Func:           listLoadBalancerDiagnosticSettings,
+				MaxConcurrency: diagnosticSettingsMaxConcurrency,
+				Tags:           map[string]string{"service": "Microsoft.Insights", "action": "diagnosticSettings/list"},
+			},
+		},
 		Columns: azureColumns([]*plugin.Column{
 			{
 				Name:        "name",
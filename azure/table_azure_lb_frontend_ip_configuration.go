@@ -0,0 +1,249 @@
+package azure
+
+import (
+	"context"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/profiles/latest/network/mgmt/network"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+)
+
+//// TABLE DEFINITION
+
+func tableAzureLoadBalancerFrontendIPConfiguration(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "azure_lb_frontend_ip_configuration",
+		Description: "Azure Load Balancer Frontend IP Configuration",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.AllColumns([]string{"load_balancer_name", "name", "resource_group"}),
+			Hydrate:    getLoadBalancerFrontendIPConfiguration,
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: isNotFoundError([]string{"ResourceNotFound", "ResourceGroupNotFound", "404"}),
+			},
+		},
+		List: &plugin.ListConfig{
+			Hydrate:       listLoadBalancerFrontendIPConfigurations,
+			ParentHydrate: listLoadBalancers,
+		},
+		Columns: azureColumns([]*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name of the resource that is unique within the set of frontend IP configurations used by the load balancer. This name can be used to access the resource.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "id",
+				Description: "The resource ID.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromGo(),
+			},
+			{
+				Name:        "load_balancer_name",
+				Description: "The friendly name that identifies the load balancer.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "provisioning_state",
+				Description: "The provisioning state of the frontend IP configuration resource. Possible values include: 'Succeeded', 'Updating', 'Deleting', 'Failed'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("FrontendIPConfigurationPropertiesFormat.ProvisioningState"),
+			},
+			{
+				Name:        "type",
+				Description: "Type of the resource.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "etag",
+				Description: "A unique read-only string that changes whenever the resource is updated.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "private_ip_address",
+				Description: "The private IP address of the IP configuration.",
+				Type:        proto.ColumnType_IPADDR,
+				Transform:   transform.FromField("FrontendIPConfigurationPropertiesFormat.PrivateIPAddress"),
+			},
+			{
+				Name:        "private_ip_allocation_method",
+				Description: "The private IP address allocation method. Possible values include: 'Static', 'Dynamic'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("FrontendIPConfigurationPropertiesFormat.PrivateIPAllocationMethod"),
+			},
+			{
+				Name:        "private_ip_address_version",
+				Description: "Whether the specific IP configuration is IPv4 or IPv6. Possible values include: 'IPv4', 'IPv6'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("FrontendIPConfigurationPropertiesFormat.PrivateIPAddressVersion"),
+			},
+			{
+				Name:        "subnet_id",
+				Description: "The resource ID of the subnet the frontend IP configuration is attached to.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("FrontendIPConfigurationPropertiesFormat.Subnet.ID"),
+			},
+			{
+				Name:        "public_ip_address_id",
+				Description: "The resource ID of the public IP address associated with the frontend IP configuration.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("FrontendIPConfigurationPropertiesFormat.PublicIPAddress.ID"),
+			},
+			{
+				Name:        "public_ip_prefix_id",
+				Description: "The resource ID of the public IP prefix associated with the frontend IP configuration.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("FrontendIPConfigurationPropertiesFormat.PublicIPPrefix.ID"),
+			},
+			{
+				Name:        "gateway_load_balancer_frontend_ip_configuration_id",
+				Description: "The resource ID of the gateway load balancer frontend IP configuration.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("FrontendIPConfigurationPropertiesFormat.GatewayLoadBalancer.ID"),
+			},
+			{
+				Name:        "zones",
+				Description: "A list of availability zones denoting the IP allocated for the resource needs to come from.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "inbound_nat_rules",
+				Description: "An array of references to inbound rules that use this frontend IP.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("FrontendIPConfigurationPropertiesFormat.InboundNatRules"),
+			},
+			{
+				Name:        "inbound_nat_pools",
+				Description: "An array of references to inbound pools that use this frontend IP.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("FrontendIPConfigurationPropertiesFormat.InboundNatPools"),
+			},
+			{
+				Name:        "outbound_rules",
+				Description: "An array of references to outbound rules that use this frontend IP.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("FrontendIPConfigurationPropertiesFormat.OutboundRules"),
+			},
+			{
+				Name:        "load_balancing_rules",
+				Description: "An array of references to load balancing rules that use this frontend IP.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("FrontendIPConfigurationPropertiesFormat.LoadBalancingRules"),
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+			{
+				Name:        "akas",
+				Description: ColumnDescriptionAkas,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("ID").Transform(idToAkas),
+			},
+
+			// Azure standard columns
+			{
+				Name:        "resource_group",
+				Description: ColumnDescriptionResourceGroup,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ID").Transform(extractResourceGroupFromID),
+			},
+		}),
+	}
+}
+
+type LoadBalancerFrontendIPConfigurationsInfo = struct {
+	network.FrontendIPConfiguration
+	LoadBalancerName string
+}
+
+//// LIST FUNCTION
+
+func listLoadBalancerFrontendIPConfigurations(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	// Get the details of load balancer
+	loadBalancer := h.Item.(network.LoadBalancer)
+
+	// Create session
+	session, err := GetNewSession(ctx, d, "MANAGEMENT")
+	if err != nil {
+		return nil, err
+	}
+	subscriptionID := session.SubscriptionID
+	resourceGroup := strings.Split(*loadBalancer.ID, "/")[4]
+
+	listLoadBalancerFrontendIPConfigurationClient := network.NewLoadBalancerFrontendIPConfigurationsClientWithBaseURI(session.ResourceManagerEndpoint, subscriptionID)
+	listLoadBalancerFrontendIPConfigurationClient.Authorizer = session.Authorizer
+
+	result, err := listLoadBalancerFrontendIPConfigurationClient.List(ctx, resourceGroup, *loadBalancer.Name)
+	if err != nil {
+		return nil, err
+	}
+	for _, config := range result.Values() {
+		d.StreamListItem(ctx, LoadBalancerFrontendIPConfigurationsInfo{config, *loadBalancer.Name})
+		// Check if context has been cancelled or if the limit has been hit (if specified)
+		// if there is a limit, it will return the number of rows required to reach this limit
+		if d.RowsRemaining(ctx) == 0 {
+			return nil, nil
+		}
+	}
+
+	for result.NotDone() {
+		err = result.NextWithContext(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, config := range result.Values() {
+			d.StreamListItem(ctx, LoadBalancerFrontendIPConfigurationsInfo{config, *loadBalancer.Name})
+			// Check if context has been cancelled or if the limit has been hit (if specified)
+			// if there is a limit, it will return the number of rows required to reach this limit
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, err
+}
+
+//// HYDRATE FUNCTION
+
+func getLoadBalancerFrontendIPConfiguration(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("getLoadBalancerFrontendIPConfiguration")
+
+	loadBalancerName := d.EqualsQuals["load_balancer_name"].GetStringValue()
+	frontendIPConfigurationName := d.EqualsQuals["name"].GetStringValue()
+	resourceGroup := d.EqualsQuals["resource_group"].GetStringValue()
+
+	// Handle empty check
+	if loadBalancerName == "" || frontendIPConfigurationName == "" || resourceGroup == "" {
+		return nil, nil
+	}
+
+	session, err := GetNewSession(ctx, d, "MANAGEMENT")
+	if err != nil {
+		return nil, err
+	}
+	subscriptionID := session.SubscriptionID
+
+	LoadBalancerFrontendIPConfigurationClient := network.NewLoadBalancerFrontendIPConfigurationsClientWithBaseURI(session.ResourceManagerEndpoint, subscriptionID)
+	LoadBalancerFrontendIPConfigurationClient.Authorizer = session.Authorizer
+
+	op, err := LoadBalancerFrontendIPConfigurationClient.Get(ctx, resourceGroup, loadBalancerName, frontendIPConfigurationName)
+	if err != nil {
+		return nil, err
+	}
+
+	// In some cases resource does not give any notFound error
+	// instead of notFound error, it returns empty data
+	if op.ID != nil {
+		return LoadBalancerFrontendIPConfigurationsInfo{op, loadBalancerName}, nil
+	}
+
+	return nil, nil
+}
@@ -3,6 +3,7 @@ package azure
 import (
 	"context"
 
+	"github.com/Azure/azure-sdk-for-go/profiles/latest/resources/mgmt/subscriptions"
 	sub "github.com/Azure/azure-sdk-for-go/profiles/latest/subscription/mgmt/subscription"
 	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
 	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
@@ -17,7 +18,7 @@ func tableAzureLocation(_ context.Context) *plugin.Table {
 		Name:        "azure_location",
 		Description: "Azure Location",
 		List: &plugin.ListConfig{
-			Hydrate: listLocations,
+			Hydrate: listAzureLocations,
 		},
 		Columns: azureColumns([]*plugin.Column{
 			{
@@ -36,15 +37,33 @@ func tableAzureLocation(_ context.Context) *plugin.Table {
 				Type:        proto.ColumnType_STRING,
 				Transform:   transform.FromGo(),
 			},
+			{
+				Name:        "type",
+				Description: "The location type. Possible values include: 'Region', 'EdgeZone'.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "regional_display_name",
+				Description: "The display name of the location and its region.",
+				Type:        proto.ColumnType_STRING,
+			},
 			{
 				Name:        "latitude",
 				Description: "The latitude of the location.",
 				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Metadata.Latitude"),
 			},
 			{
 				Name:        "longitude",
 				Description: "The longitude of the location",
 				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Metadata.Longitude"),
+			},
+			{
+				Name:        "metadata",
+				Description: "Metadata of the location, such as the region type and category, geography group, latitude, longitude, physical location, paired regions and home location.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Metadata").Transform(extractLocationMetadata),
 			},
 
 			// Steampipe standard columns
@@ -66,6 +85,41 @@ func tableAzureLocation(_ context.Context) *plugin.Table {
 
 //// LIST FUNCTION
 
+func listAzureLocations(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	session, err := GetNewSession(ctx, d, "MANAGEMENT")
+	if err != nil {
+		return nil, err
+	}
+	subscriptionID := session.SubscriptionID
+
+	client := subscriptions.NewClientWithBaseURI(session.ResourceManagerEndpoint)
+	client.Authorizer = session.Authorizer
+
+	// Include the edge zones along with the regions
+	includeExtendedLocations := true
+	result, err := client.ListLocations(ctx, subscriptionID, &includeExtendedLocations)
+	if err != nil {
+		plugin.Logger(ctx).Error("listAzureLocations", "list", err)
+		return nil, err
+	}
+
+	if result.Value == nil {
+		return nil, nil
+	}
+
+	for _, location := range *result.Value {
+		d.StreamListItem(ctx, location)
+		// Check if context has been cancelled or if the limit has been hit (if specified)
+		// if there is a limit, it will return the number of rows required to reach this limit
+		if d.RowsRemaining(ctx) == 0 {
+			return nil, nil
+		}
+	}
+
+	return nil, nil
+}
+
+// listLocations is used as the parent hydrate of the location scoped tables
 func listLocations(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
 	session, err := GetNewSession(ctx, d, "MANAGEMENT")
 	if err != nil {
@@ -92,3 +146,50 @@ func listLocations(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateDa
 
 	return nil, err
 }
+
+//// TRANSFORM FUNCTIONS
+
+// The SDK marshalers drop the read-only fields of the location metadata and of
+// the paired regions, so the map is built here
+func extractLocationMetadata(ctx context.Context, d *transform.TransformData) (interface{}, error) {
+	metadata, ok := d.Value.(*subscriptions.LocationMetadata)
+	if !ok || metadata == nil {
+		return nil, nil
+	}
+
+	objectMap := make(map[string]interface{})
+	if metadata.RegionType != "" {
+		objectMap["regionType"] = metadata.RegionType
+	}
+	if metadata.RegionCategory != "" {
+		objectMap["regionCategory"] = metadata.RegionCategory
+	}
+	if metadata.GeographyGroup != nil {
+		objectMap["geographyGroup"] = metadata.GeographyGroup
+	}
+	if metadata.Longitude != nil {
+		objectMap["longitude"] = metadata.Longitude
+	}
+	if metadata.Latitude != nil {
+		objectMap["latitude"] = metadata.Latitude
+	}
+	if metadata.PhysicalLocation != nil {
+		objectMap["physicalLocation"] = metadata.PhysicalLocation
+	}
+	if metadata.PairedRegion != nil {
+		pairedRegions := []map[string]interface{}{}
+		for _, region := range *metadata.PairedRegion {
+			pairedRegions = append(pairedRegions, map[string]interface{}{
+				"name":           region.Name,
+				"id":             region.ID,
+				"subscriptionId": region.SubscriptionID,
+			})
+		}
+		objectMap["pairedRegion"] = pairedRegions
+	}
+	if metadata.HomeLocation != nil {
+		objectMap["homeLocation"] = metadata.HomeLocation
+	}
+
+	return objectMap, nil
+}
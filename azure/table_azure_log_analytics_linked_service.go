@@ -0,0 +1,197 @@
+package azure
+
+import (
+	"context"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/profiles/latest/operationalinsights/mgmt/operationalinsights"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+//// TABLE DEFINITION ////
+
+func tableAzureLogAnalyticsLinkedService(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "azure_log_analytics_linked_service",
+		Description: "Azure Log Analytics Linked Service",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.AllColumns([]string{"workspace_name", "name", "resource_group"}),
+			Hydrate:    getLogAnalyticsLinkedService,
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: isNotFoundError([]string{"ResourceNotFound", "ResourceGroupNotFound", "404"}),
+			},
+		},
+		List: &plugin.ListConfig{
+			Hydrate:       listLogAnalyticsLinkedServices,
+			ParentHydrate: listLogAnalyticsWorkspaces,
+		},
+		Columns: azureColumns([]*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name of the linked service.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "id",
+				Description: "The ID of the linked service.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromGo(),
+			},
+			{
+				Name:        "type",
+				Description: "The type of the resource.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "workspace_name",
+				Description: "The name of the workspace the linked service belongs to.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "resource_id",
+				Description: "The ID of the resource linked to the workspace with read access.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("LinkedServiceProperties.ResourceID"),
+			},
+			{
+				Name:        "write_access_resource_id",
+				Description: "The ID of the resource linked to the workspace with write access.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("LinkedServiceProperties.WriteAccessResourceID"),
+			},
+			{
+				Name:        "provisioning_state",
+				Description: "The provisioning state of the linked service. Possible values include: 'Succeeded', 'Deleting', 'ProvisioningAccount', 'Updating'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("LinkedServiceProperties.ProvisioningState"),
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+			{
+				Name:        "tags",
+				Description: ColumnDescriptionTags,
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "akas",
+				Description: ColumnDescriptionAkas,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("ID").Transform(idToAkas),
+			},
+
+			// Azure standard columns
+			{
+				Name:        "region",
+				Description: ColumnDescriptionRegion,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Location").Transform(toLower),
+			},
+			{
+				Name:        "resource_group",
+				Description: ColumnDescriptionResourceGroup,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ID").Transform(extractResourceGroupFromID),
+			},
+		}),
+	}
+}
+
+type LogAnalyticsLinkedServiceInfo = struct {
+	operationalinsights.LinkedService
+	WorkspaceName *string
+	Location      *string
+}
+
+//// LIST FUNCTION ////
+
+func listLogAnalyticsLinkedServices(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	logger := plugin.Logger(ctx)
+
+	workspace := h.Item.(operationalinsights.Workspace)
+	resourceGroup := strings.Split(*workspace.ID, "/")[4]
+
+	session, err := GetNewSession(ctx, d, "MANAGEMENT")
+	if err != nil {
+		logger.Error("azure_log_analytics_linked_service.listLogAnalyticsLinkedServices", "connection_error", err)
+		return nil, err
+	}
+	subscriptionID := session.SubscriptionID
+
+	client := operationalinsights.NewLinkedServicesClientWithBaseURI(session.ResourceManagerEndpoint, subscriptionID)
+	client.Authorizer = session.Authorizer
+
+	result, err := client.ListByWorkspace(ctx, resourceGroup, *workspace.Name)
+	if err != nil {
+		logger.Error("azure_log_analytics_linked_service.listLogAnalyticsLinkedServices", "api_error", err)
+		return nil, err
+	}
+
+	if result.Value == nil {
+		return nil, nil
+	}
+
+	for _, linkedService := range *result.Value {
+		d.StreamListItem(ctx, LogAnalyticsLinkedServiceInfo{linkedService, workspace.Name, workspace.Location})
+		// Check if context has been cancelled or if the limit has been hit (if specified)
+		if d.RowsRemaining(ctx) == 0 {
+			return nil, nil
+		}
+	}
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS ////
+
+func getLogAnalyticsLinkedService(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	logger := plugin.Logger(ctx)
+
+	workspaceName := d.EqualsQuals["workspace_name"].GetStringValue()
+	name := d.EqualsQuals["name"].GetStringValue()
+	resourceGroup := d.EqualsQuals["resource_group"].GetStringValue()
+
+	if workspaceName == "" || name == "" || resourceGroup == "" {
+		return nil, nil
+	}
+
+	session, err := GetNewSession(ctx, d, "MANAGEMENT")
+	if err != nil {
+		logger.Error("azure_log_analytics_linked_service.getLogAnalyticsLinkedService", "connection_error", err)
+		return nil, err
+	}
+	subscriptionID := session.SubscriptionID
+
+	client := operationalinsights.NewLinkedServicesClientWithBaseURI(session.ResourceManagerEndpoint, subscriptionID)
+	client.Authorizer = session.Authorizer
+
+	op, err := client.Get(ctx, resourceGroup, workspaceName, name)
+	if err != nil {
+		logger.Error("azure_log_analytics_linked_service.getLogAnalyticsLinkedService", "api_error", err)
+		return nil, err
+	}
+
+	// In some cases resource does not give any notFound error
+	// instead of notFound error, it returns empty data
+	if op.ID == nil {
+		return nil, nil
+	}
+
+	// The linked service does not return the location, so it is taken from the workspace
+	workspaceClient := operationalinsights.NewWorkspacesClientWithBaseURI(session.ResourceManagerEndpoint, subscriptionID)
+	workspaceClient.Authorizer = session.Authorizer
+
+	workspace, err := workspaceClient.Get(ctx, resourceGroup, workspaceName)
+	if err != nil {
+		logger.Error("azure_log_analytics_linked_service.getLogAnalyticsLinkedService", "get_workspace_error", err)
+		return nil, err
+	}
+
+	return LogAnalyticsLinkedServiceInfo{op, workspace.Name, workspace.Location}, nil
+}
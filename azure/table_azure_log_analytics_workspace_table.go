@@ -0,0 +1,248 @@
+package azure
+
+import (
+	"context"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/profiles/latest/operationalinsights/mgmt/operationalinsights"
+	operationalinsightspreview "github.com/Azure/azure-sdk-for-go/profiles/preview/preview/operationalinsights/mgmt/operationalinsights"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+//// TABLE DEFINITION ////
+
+func tableAzureLogAnalyticsWorkspaceTable(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "azure_log_analytics_workspace_table",
+		Description: "Azure Log Analytics Workspace Table",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.AllColumns([]string{"workspace_name", "name", "resource_group"}),
+			Hydrate:    getLogAnalyticsWorkspaceTable,
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: isNotFoundError([]string{"ResourceNotFound", "ResourceGroupNotFound", "404"}),
+			},
+		},
+		List: &plugin.ListConfig{
+			Hydrate:       listLogAnalyticsWorkspaceTables,
+			ParentHydrate: listLogAnalyticsWorkspaces,
+		},
+		Columns: azureColumns([]*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name of the table.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "id",
+				Description: "The ID of the table.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromGo(),
+			},
+			{
+				Name:        "type",
+				Description: "The type of the resource.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "workspace_name",
+				Description: "The name of the workspace the table belongs to.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "plan",
+				Description: "The plan of the table, which defines how the ingested logs are handled and charged. Possible values include: 'Basic', 'Analytics'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("TableProperties.Plan"),
+			},
+			{
+				Name:        "provisioning_state",
+				Description: "The provisioning state of the table. Possible values include: 'Updating', 'InProgress', 'Succeeded'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("TableProperties.ProvisioningState"),
+			},
+			{
+				Name:        "retention_in_days",
+				Description: "The interactive retention of the table in days. Defaults to the retention of the workspace.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("TableProperties.RetentionInDays"),
+			},
+			{
+				Name:        "total_retention_in_days",
+				Description: "The total retention of the table in days, including the archive retention.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("TableProperties.TotalRetentionInDays"),
+			},
+			{
+				Name:        "archive_retention_in_days",
+				Description: "The archive retention of the table in days, calculated as the total retention minus the interactive retention.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("TableProperties.ArchiveRetentionInDays"),
+			},
+			{
+				Name:        "last_plan_modified_date",
+				Description: "The time when the plan of the table was last modified.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("TableProperties.LastPlanModifiedDate"),
+			},
+			{
+				Name:        "table_type",
+				Description: "The type of the table. Possible values include: 'Microsoft', 'CustomLog', 'RestoredLogs', 'SearchResults'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("TableProperties.Schema.TableType"),
+			},
+			{
+				Name:        "solutions",
+				Description: "The list of solutions the table is affiliated with.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("TableProperties.Schema.Solutions"),
+			},
+			{
+				Name:        "schema",
+				Description: "The schema of the table, with its custom and standard columns and their types.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("TableProperties.Schema"),
+			},
+			{
+				Name:        "search_results",
+				Description: "The parameters of the search job that created the table.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("TableProperties.SearchResults"),
+			},
+			{
+				Name:        "restored_logs",
+				Description: "The parameters of the restore operation that created the table.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("TableProperties.RestoredLogs"),
+			},
+			{
+				Name:        "result_statistics",
+				Description: "The execution statistics of the search job that created the table.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("TableProperties.ResultStatistics"),
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+			{
+				Name:        "akas",
+				Description: ColumnDescriptionAkas,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("ID").Transform(idToAkas),
+			},
+
+			// Azure standard columns
+			{
+				Name:        "region",
+				Description: ColumnDescriptionRegion,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Location").Transform(toLower),
+			},
+			{
+				Name:        "resource_group",
+				Description: ColumnDescriptionResourceGroup,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ID").Transform(extractResourceGroupFromID),
+			},
+		}),
+	}
+}
+
+type LogAnalyticsWorkspaceTableInfo = struct {
+	operationalinsightspreview.Table
+	WorkspaceName *string
+	Location      *string
+}
+
+//// LIST FUNCTION ////
+
+func listLogAnalyticsWorkspaceTables(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	logger := plugin.Logger(ctx)
+
+	workspace := h.Item.(operationalinsights.Workspace)
+	resourceGroup := strings.Split(*workspace.ID, "/")[4]
+
+	session, err := GetNewSession(ctx, d, "MANAGEMENT")
+	if err != nil {
+		logger.Error("azure_log_analytics_workspace_table.listLogAnalyticsWorkspaceTables", "connection_error", err)
+		return nil, err
+	}
+	subscriptionID := session.SubscriptionID
+
+	// The tables API is only available in the preview API versions
+	client := operationalinsightspreview.NewTablesClientWithBaseURI(session.ResourceManagerEndpoint, subscriptionID)
+	client.Authorizer = session.Authorizer
+
+	result, err := client.ListByWorkspace(ctx, resourceGroup, *workspace.Name)
+	if err != nil {
+		logger.Error("azure_log_analytics_workspace_table.listLogAnalyticsWorkspaceTables", "api_error", err)
+		return nil, err
+	}
+
+	if result.Value == nil {
+		return nil, nil
+	}
+
+	for _, table := range *result.Value {
+		d.StreamListItem(ctx, LogAnalyticsWorkspaceTableInfo{table, workspace.Name, workspace.Location})
+		// Check if context has been cancelled or if the limit has been hit (if specified)
+		if d.RowsRemaining(ctx) == 0 {
+			return nil, nil
+		}
+	}
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS ////
+
+func getLogAnalyticsWorkspaceTable(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	logger := plugin.Logger(ctx)
+
+	workspaceName := d.EqualsQuals["workspace_name"].GetStringValue()
+	name := d.EqualsQuals["name"].GetStringValue()
+	resourceGroup := d.EqualsQuals["resource_group"].GetStringValue()
+
+	if workspaceName == "" || name == "" || resourceGroup == "" {
+		return nil, nil
+	}
+
+	session, err := GetNewSession(ctx, d, "MANAGEMENT")
+	if err != nil {
+		logger.Error("azure_log_analytics_workspace_table.getLogAnalyticsWorkspaceTable", "connection_error", err)
+		return nil, err
+	}
+	subscriptionID := session.SubscriptionID
+
+	client := operationalinsightspreview.NewTablesClientWithBaseURI(session.ResourceManagerEndpoint, subscriptionID)
+	client.Authorizer = session.Authorizer
+
+	op, err := client.Get(ctx, resourceGroup, workspaceName, name)
+	if err != nil {
+		logger.Error("azure_log_analytics_workspace_table.getLogAnalyticsWorkspaceTable", "api_error", err)
+		return nil, err
+	}
+
+	// In some cases resource does not give any notFound error
+	// instead of notFound error, it returns empty data
+	if op.ID == nil {
+		return nil, nil
+	}
+
+	// The table does not return the location, so it is taken from the workspace
+	workspaceClient := operationalinsights.NewWorkspacesClientWithBaseURI(session.ResourceManagerEndpoint, subscriptionID)
+	workspaceClient.Authorizer = session.Authorizer
+
+	workspace, err := workspaceClient.Get(ctx, resourceGroup, workspaceName)
+	if err != nil {
+		logger.Error("azure_log_analytics_workspace_table.getLogAnalyticsWorkspaceTable", "get_workspace_error", err)
+		return nil, err
+	}
+
+	return LogAnalyticsWorkspaceTableInfo{op, workspace.Name, workspace.Location}, nil
+}
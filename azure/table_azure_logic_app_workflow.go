@@ -2,6 +2,7 @@ package azure
 
 import (
 	"context"
+	"strings"
 
 	"github.com/Azure/azure-sdk-for-go/profiles/latest/logic/mgmt/logic"
 	"github.com/Azure/azure-sdk-for-go/profiles/preview/preview/monitor/mgmt/insights"
@@ -26,6 +27,19 @@ func tableAzureLogicAppWorkflow(_ context.Context) *plugin.Table {
 		},
 		List: &plugin.ListConfig{
 			Hydrate: listLogicAppWorkflows,
+			KeyColumns: plugin.KeyColumnSlice{
+				{
+					Name:    "resource_group",
+					Require: plugin.Optional,
+				},
+			},
+		},
+		HydrateConfig: []plugin.HydrateConfig{
+			{
+				Func:           listLogicAppWorkflowDiagnosticSettings,
+				MaxConcurrency: diagnosticSettingsMaxConcurrency,
+				Tags:           map[string]string{"service": "Microsoft.Insights", "action": "diagnosticSettings/list"},
+			},
 		},
 		Columns: azureColumns([]*plugin.Column{
 			{
@@ -135,6 +149,19 @@ func tableAzureLogicAppWorkflow(_ context.Context) *plugin.Table {
 				Type:        proto.ColumnType_JSON,
 				Transform:   transform.FromField("WorkflowProperties.Sku.Plan"),
 			},
+			{
+				Name:        "sku_plan_id",
+				Description: "The resource id of the sku plan.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("WorkflowProperties.Sku.Plan.ID"),
+			},
+			{
+				Name:        "trigger_count",
+				Description: "The number of triggers defined for the workflow.",
+				Type:        proto.ColumnType_INT,
+				Hydrate:     getLogicAppWorkflowTriggerCount,
+				Transform:   transform.FromValue(),
+			},
 
 			// Steampipe standard columns
 			{
@@ -183,7 +210,13 @@ func listLogicAppWorkflows(ctx context.Context, d *plugin.QueryData, _ *plugin.H
 
 	workflowClient := logic.NewWorkflowsClientWithBaseURI(session.ResourceManagerEndpoint, subscriptionID)
 	workflowClient.Authorizer = session.Authorizer
-	result, err := workflowClient.ListBySubscription(ctx, nil, "")
+
+	var result logic.WorkflowListResultPage
+	if resourceGroup := d.EqualsQualString("resource_group"); resourceGroup != "" {
+		result, err = workflowClient.ListByResourceGroup(ctx, resourceGroup, nil, "")
+	} else {
+		result, err = workflowClient.ListBySubscription(ctx, nil, "")
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -285,6 +318,41 @@ func listLogicAppWorkflowDiagnosticSettings(ctx context.Context, d *plugin.Query
 	return diagnosticSettings, nil
 }
 
+func getLogicAppWorkflowTriggerCount(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("getLogicAppWorkflowTriggerCount")
+
+	workflow := h.Item.(logic.Workflow)
+	resourceGroup := strings.Split(*workflow.ID, "/")[4]
+
+	// Create session
+	session, err := GetNewSession(ctx, d, "MANAGEMENT")
+	if err != nil {
+		return nil, err
+	}
+	subscriptionID := session.SubscriptionID
+
+	client := logic.NewWorkflowTriggersClientWithBaseURI(session.ResourceManagerEndpoint, subscriptionID)
+	client.Authorizer = session.Authorizer
+
+	op, err := client.List(ctx, resourceGroup, *workflow.Name, nil, "")
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_logic_app_workflow.getLogicAppWorkflowTriggerCount", "api_error", err)
+		return nil, err
+	}
+
+	count := len(op.Values())
+	for op.NotDone() {
+		err = op.NextWithContext(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("azure_logic_app_workflow.getLogicAppWorkflowTriggerCount", "api_paging_error", err)
+			return nil, err
+		}
+		count += len(op.Values())
+	}
+
+	return count, nil
+}
+
 //// TRANSFORM FUNCTION
 
 // Access Control configuration for any IP is coming as "{}" instead of nil if we are not providing any IP in configuration
@@ -28,6 +28,13 @@ func tableAzureMachineLearningWorkspace(_ context.Context) *plugin.Table {
 		List: &plugin.ListConfig{
 			Hydrate: listMachineLearningWorkspaces,
 		},
+		HydrateConfig: []plugin.HydrateConfig{
+			{
+				Func:           listMachineLearningWorkspaceDiagnosticSettings,
+				MaxConcurrency: diagnosticSettingsMaxConcurrency,
+				Tags:           map[string]string{"service": "Microsoft.Insights", "action": "diagnosticSettings/list"},
+			},
+		},
 		Columns: azureColumns([]*plugin.Column{
 			{
 				Name:        "name",
@@ -0,0 +1,261 @@
+package azure
+
+import (
+	"context"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/profiles/latest/resources/mgmt/managementgroups"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+)
+
+//// TABLE DEFINITION
+
+func tableAzureManagementGroupSubscriptionAssociation(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "azure_management_group_subscription_association",
+		Description: "Azure Management Group Subscription Association",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.AllColumns([]string{"management_group_id", "subscription_id"}),
+			Hydrate:    getManagementGroupSubscriptionAssociation,
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: isNotFoundError([]string{"NotFound", "404"}),
+			},
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listManagementGroupSubscriptionAssociations,
+		},
+		Columns: []*plugin.Column{
+			{
+				Name:        "management_group_id",
+				Description: "The fully qualified ID of the management group the subscription belongs to.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ManagementGroupID"),
+			},
+			{
+				Name:        "management_group_name",
+				Description: "The name of the management group the subscription belongs to.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "management_group_display_name",
+				Description: "The friendly name of the management group the subscription belongs to.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "subscription_id",
+				Description: "The ID of the subscription.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("SubscriptionID"),
+			},
+			{
+				Name:        "subscription_display_name",
+				Description: "The friendly name of the subscription.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "tenant_id",
+				Description: "The AAD Tenant ID associated with the management group.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("TenantID"),
+			},
+			{
+				Name:        "path",
+				Description: "The fully qualified IDs of the ancestor management groups of the subscription, from the root management group to the management group the subscription belongs to.",
+				Type:        proto.ColumnType_JSON,
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("SubscriptionDisplayName", "SubscriptionID"),
+			},
+		},
+	}
+}
+
+type ManagementGroupSubscriptionAssociation struct {
+	ManagementGroupID          *string
+	ManagementGroupName        *string
+	ManagementGroupDisplayName *string
+	SubscriptionID             *string
+	SubscriptionDisplayName    *string
+	TenantID                   *string
+	Path                       []string
+}
+
+//// LIST FUNCTION
+
+func listManagementGroupSubscriptionAssociations(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	session, err := GetNewSession(ctx, d, "MANAGEMENT")
+	if err != nil {
+		return nil, err
+	}
+
+	mgClient := managementgroups.NewClient()
+	mgClient.Authorizer = session.Authorizer
+
+	result, err := mgClient.ListComplete(ctx, "", "")
+	if err != nil {
+		plugin.Logger(ctx).Error("listManagementGroupSubscriptionAssociations", "list", err)
+		return nil, err
+	}
+
+	var groups []managementgroups.Info
+	for result.NotDone() {
+		group := result.Value()
+		// The tenant root group is walked first, so that the path of every
+		// subscription it can reach starts from the root
+		if group.InfoProperties != nil && group.TenantID != nil && group.Name != nil && *group.Name == *group.TenantID {
+			groups = append([]managementgroups.Info{group}, groups...)
+		} else {
+			groups = append(groups, group)
+		}
+		err = result.NextWithContext(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("listManagementGroupSubscriptionAssociations", "list_paging", err)
+			return nil, err
+		}
+	}
+
+	// Management groups already reached while walking the tree of a previous group
+	visited := map[string]bool{}
+	recurse := true
+
+	for _, group := range groups {
+		if group.Name == nil || visited[*group.Name] {
+			continue
+		}
+
+		op, err := mgClient.Get(ctx, *group.Name, "children", &recurse, "", "")
+		if err != nil {
+			plugin.Logger(ctx).Error("listManagementGroupSubscriptionAssociations", "get", err)
+			return nil, err
+		}
+		if op.ID == nil || op.Properties == nil {
+			continue
+		}
+
+		associations := walkManagementGroupChildren(op.ID, op.Name, op.DisplayName, op.TenantID, op.Children, []string{}, visited)
+		for _, association := range associations {
+			d.StreamListItem(ctx, association)
+			// Check if context has been cancelled or if the limit has been hit (if specified)
+			// if there is a limit, it will return the number of rows required to reach this limit
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getManagementGroupSubscriptionAssociation(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("getManagementGroupSubscriptionAssociation")
+
+	managementGroupID := d.EqualsQualString("management_group_id")
+	subscriptionID := d.EqualsQualString("subscription_id")
+
+	// Handle empty check
+	if managementGroupID == "" || subscriptionID == "" {
+		return nil, nil
+	}
+	name := managementGroupID[strings.LastIndex(managementGroupID, "/")+1:]
+
+	session, err := GetNewSession(ctx, d, "MANAGEMENT")
+	if err != nil {
+		return nil, err
+	}
+
+	mgClient := managementgroups.NewClient()
+	mgClient.Authorizer = session.Authorizer
+
+	op, err := mgClient.Get(ctx, name, "children", nil, "", "")
+	if err != nil {
+		plugin.Logger(ctx).Error("getManagementGroupSubscriptionAssociation", "get", err)
+		return nil, err
+	}
+	if op.ID == nil || op.Properties == nil || op.Children == nil {
+		return nil, nil
+	}
+
+	for _, child := range *op.Children {
+		if child.Type != managementgroups.Type1Subscriptions || child.Name == nil || *child.Name != subscriptionID {
+			continue
+		}
+
+		// The path of the management group is only returned when it is expanded
+		pathOp, err := mgClient.Get(ctx, name, "path", nil, "", "")
+		if err != nil {
+			plugin.Logger(ctx).Error("getManagementGroupSubscriptionAssociation", "get_path", err)
+			return nil, err
+		}
+
+		path := []string{}
+		if pathOp.Properties != nil && pathOp.Path != nil {
+			for _, element := range *pathOp.Path {
+				if element.Name != nil {
+					path = append(path, "/providers/Microsoft.Management/managementGroups/"+*element.Name)
+				}
+			}
+		}
+		path = append(path, *op.ID)
+
+		return &ManagementGroupSubscriptionAssociation{
+			ManagementGroupID:          op.ID,
+			ManagementGroupName:        op.Name,
+			ManagementGroupDisplayName: op.DisplayName,
+			SubscriptionID:             child.Name,
+			SubscriptionDisplayName:    child.DisplayName,
+			TenantID:                   op.TenantID,
+			Path:                       path,
+		}, nil
+	}
+
+	return nil, nil
+}
+
+//// UTILITY FUNCTIONS
+
+// walkManagementGroupChildren returns the subscriptions found under the given management group and its descendants
+func walkManagementGroupChildren(id, name, displayName, tenantID *string, children *[]managementgroups.ChildInfo, ancestors []string, visited map[string]bool) []*ManagementGroupSubscriptionAssociation {
+	var associations []*ManagementGroupSubscriptionAssociation
+	if name != nil {
+		visited[*name] = true
+	}
+
+	// Copy the ancestors, so that the path of sibling groups is not shared
+	path := append(append([]string{}, ancestors...), *id)
+
+	if children == nil {
+		return associations
+	}
+
+	for _, child := range *children {
+		switch child.Type {
+		case managementgroups.Type1Subscriptions:
+			associations = append(associations, &ManagementGroupSubscriptionAssociation{
+				ManagementGroupID:          id,
+				ManagementGroupName:        name,
+				ManagementGroupDisplayName: displayName,
+				SubscriptionID:             child.Name,
+				SubscriptionDisplayName:    child.DisplayName,
+				TenantID:                   tenantID,
+				Path:                       path,
+			})
+		case managementgroups.Type1MicrosoftManagementmanagementGroups:
+			if child.ID == nil || (child.Name != nil && visited[*child.Name]) {
+				continue
+			}
+			associations = append(associations, walkManagementGroupChildren(child.ID, child.Name, child.DisplayName, tenantID, child.Children, path, visited)...)
+		}
+	}
+
+	return associations
+}
@@ -0,0 +1,266 @@
+package azure
+
+import (
+	"context"
+
+	"github.com/Azure/azure-sdk-for-go/profiles/latest/maps/mgmt/maps"
+	"github.com/Azure/azure-sdk-for-go/profiles/latest/resources/mgmt/resources"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+)
+
+// The Maps API version of the Azure SDK used by the plugin does not return the
+// linked resources, CORS, encryption and identity of an account, so they are
+// read as a generic resource
+const mapsAccountAPIVersion = "2023-06-01"
+
+//// TABLE DEFINITION
+
+func tableAzureMapsAccount(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "azure_maps_account",
+		Description: "Azure Maps Account",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.AllColumns([]string{"name", "resource_group"}),
+			Hydrate:    getMapsAccount,
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: isNotFoundError([]string{"ResourceNotFound", "ResourceGroupNotFound", "404"}),
+			},
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listMapsAccounts,
+		},
+		Columns: azureColumns([]*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name of the Maps account.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "id",
+				Description: "The ID of the Maps account.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromGo(),
+			},
+			{
+				Name:        "type",
+				Description: "The type of the resource.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "provisioning_state",
+				Description: "The provisioning state of the Maps account resource.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties.ProvisioningState"),
+			},
+			{
+				Name:        "unique_id",
+				Description: "A unique identifier for the Maps account.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties.UniqueID"),
+			},
+			{
+				Name:        "disable_local_auth",
+				Description: "Indicates whether local authentication methods, such as shared keys, are disabled. Only Azure AD authentication can be used when it is true.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("Properties.DisableLocalAuth"),
+			},
+			{
+				Name:        "kind",
+				Description: "The kind of the Maps account. Possible values include: 'Gen1', 'Gen2'.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "sku_name",
+				Description: "The name of the SKU. Possible values include: 'S0', 'S1', 'G2'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Sku.Name"),
+			},
+			{
+				Name:        "sku_tier",
+				Description: "The SKU tier.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Sku.Tier"),
+			},
+			{
+				Name:        "linked_resources",
+				Description: "The array of associated resources to the Maps account.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getMapsAccountProperties,
+				Transform:   transform.FromField("Properties").TransformP(extractGenericResourceProperty, "linkedResources"),
+			},
+			{
+				Name:        "cors",
+				Description: "The CORS rules of the Maps account.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getMapsAccountProperties,
+				Transform:   transform.FromField("Properties").TransformP(extractGenericResourceProperty, "cors"),
+			},
+			{
+				Name:        "data_stores",
+				Description: "The data stores of the Maps account. Only returned by API versions that include data stores.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getMapsAccountProperties,
+				Transform:   transform.FromField("Properties").TransformP(extractGenericResourceProperty, "dataStores"),
+			},
+			{
+				Name:        "encryption",
+				Description: "The encryption settings of the Maps account, including customer-managed keys.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getMapsAccountProperties,
+				Transform:   transform.FromField("Properties").TransformP(extractGenericResourceProperty, "encryption"),
+			},
+			{
+				Name:        "identity",
+				Description: "The managed service identity of the Maps account.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getMapsAccountProperties,
+				Transform:   transform.FromField("Identity"),
+			},
+			{
+				Name:        "system_data",
+				Description: "Metadata pertaining to creation and last modification of the resource.",
+				Type:        proto.ColumnType_JSON,
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+			{
+				Name:        "tags",
+				Description: ColumnDescriptionTags,
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "akas",
+				Description: ColumnDescriptionAkas,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("ID").Transform(idToAkas),
+			},
+
+			// Azure standard columns
+			{
+				Name:        "region",
+				Description: ColumnDescriptionRegion,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Location").Transform(toLower),
+			},
+			{
+				Name:        "resource_group",
+				Description: ColumnDescriptionResourceGroup,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ID").Transform(extractResourceGroupFromID),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listMapsAccounts(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	session, err := GetNewSession(ctx, d, "MANAGEMENT")
+	if err != nil {
+		return nil, err
+	}
+	subscriptionID := session.SubscriptionID
+
+	client := maps.NewAccountsClientWithBaseURI(session.ResourceManagerEndpoint, subscriptionID)
+	client.Authorizer = session.Authorizer
+
+	result, err := client.ListBySubscription(ctx)
+	if err != nil {
+		plugin.Logger(ctx).Error("listMapsAccounts", "list", err)
+		return nil, err
+	}
+
+	for _, account := range result.Values() {
+		d.StreamListItem(ctx, account)
+		// Check if context has been cancelled or if the limit has been hit (if specified)
+		// if there is a limit, it will return the number of rows required to reach this limit
+		if d.RowsRemaining(ctx) == 0 {
+			return nil, nil
+		}
+	}
+
+	for result.NotDone() {
+		err = result.NextWithContext(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("listMapsAccounts", "list_paging", err)
+			return nil, err
+		}
+		for _, account := range result.Values() {
+			d.StreamListItem(ctx, account)
+			// Check if context has been cancelled or if the limit has been hit (if specified)
+			// if there is a limit, it will return the number of rows required to reach this limit
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, err
+}
+
+//// HYDRATE FUNCTIONS
+
+func getMapsAccount(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("getMapsAccount")
+
+	name := d.EqualsQuals["name"].GetStringValue()
+	resourceGroup := d.EqualsQuals["resource_group"].GetStringValue()
+
+	// Handle empty name or resourceGroup
+	if name == "" || resourceGroup == "" {
+		return nil, nil
+	}
+
+	session, err := GetNewSession(ctx, d, "MANAGEMENT")
+	if err != nil {
+		return nil, err
+	}
+	subscriptionID := session.SubscriptionID
+
+	client := maps.NewAccountsClientWithBaseURI(session.ResourceManagerEndpoint, subscriptionID)
+	client.Authorizer = session.Authorizer
+
+	op, err := client.Get(ctx, resourceGroup, name)
+	if err != nil {
+		plugin.Logger(ctx).Error("getMapsAccount", "get", err)
+		return nil, err
+	}
+
+	// In some cases resource does not give any notFound error
+	// instead of notFound error, it returns empty data
+	if op.ID != nil {
+		return op, nil
+	}
+
+	return nil, nil
+}
+
+func getMapsAccountProperties(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	account := h.Item.(maps.Account)
+
+	session, err := GetNewSession(ctx, d, "MANAGEMENT")
+	if err != nil {
+		return nil, err
+	}
+	subscriptionID := session.SubscriptionID
+
+	client := resources.NewClientWithBaseURI(session.ResourceManagerEndpoint, subscriptionID)
+	client.Authorizer = session.Authorizer
+
+	op, err := client.GetByID(ctx, *account.ID, mapsAccountAPIVersion)
+	if err != nil {
+		plugin.Logger(ctx).Error("getMapsAccountProperties", "get", err)
+		return nil, err
+	}
+
+	return op, nil
+}
@@ -0,0 +1,388 @@
+package azure
+
+import (
+	"context"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/profiles/latest/mediaservices/mgmt/media"
+	"github.com/Azure/azure-sdk-for-go/profiles/latest/resources/mgmt/resources"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+)
+
+// The Media Services API version of the Azure SDK used by the plugin does not
+// return the provisioning state and minimum TLS version of an account, so they
+// are read as a generic resource
+const mediaServiceAPIVersion = "2023-01-01"
+
+//// TABLE DEFINITION
+
+func tableAzureMediaService(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "azure_media_service",
+		Description: "Azure Media Service",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.AllColumns([]string{"name", "resource_group"}),
+			Hydrate:    getMediaService,
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: isNotFoundError([]string{"ResourceNotFound", "ResourceGroupNotFound", "404"}),
+			},
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listMediaServices,
+		},
+		Columns: azureColumns([]*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name of the Media Services account.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "id",
+				Description: "The ID of the Media Services account.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromGo(),
+			},
+			{
+				Name:        "type",
+				Description: "The type of the resource.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "provisioning_state",
+				Description: "The provisioning state of the Media Services account.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getMediaServiceProperties,
+				Transform:   transform.FromField("Properties").TransformP(extractGenericResourceProperty, "provisioningState"),
+			},
+			{
+				Name:        "media_service_id",
+				Description: "The Media Services account ID.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ServiceProperties.MediaServiceID").Transform(transform.ToString),
+			},
+			{
+				Name:        "storage_authentication",
+				Description: "The storage authentication mode of the account. Possible values include: 'System', 'ManagedIdentity'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ServiceProperties.StorageAuthentication"),
+			},
+			{
+				Name:        "public_network_access",
+				Description: "Whether or not public network access is allowed for resources under the Media Services account. Possible values include: 'Enabled', 'Disabled'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ServiceProperties.PublicNetworkAccess"),
+			},
+			{
+				Name:        "minimum_tls_version",
+				Description: "The minimum TLS version allowed for the Media Services account's requests. Possible values include: 'Tls10', 'Tls11', 'Tls12', 'Tls13'.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getMediaServiceProperties,
+				Transform:   transform.FromField("Properties").TransformP(extractGenericResourceProperty, "minimumTlsVersion"),
+			},
+			{
+				Name:        "storage_accounts",
+				Description: "The storage accounts associated with the Media Services account.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.From(extractMediaServiceStorageAccounts),
+			},
+			{
+				Name:        "key_delivery",
+				Description: "The Key Delivery properties for the Media Services account, including the IP access control settings.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("ServiceProperties.KeyDelivery"),
+			},
+			{
+				Name:        "encryption",
+				Description: "The account encryption properties.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.From(extractMediaServiceEncryption),
+			},
+			{
+				Name:        "identity",
+				Description: "The managed identity for the Media Services account.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.From(extractMediaServiceIdentity),
+			},
+			{
+				Name:        "private_endpoint_connections",
+				Description: "The private endpoint connections of the Media Services account.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     listMediaServicePrivateEndpointConnections,
+				Transform:   transform.FromValue(),
+			},
+			{
+				Name:        "system_data",
+				Description: "The system metadata relating to this resource.",
+				Type:        proto.ColumnType_JSON,
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+			{
+				Name:        "tags",
+				Description: ColumnDescriptionTags,
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "akas",
+				Description: ColumnDescriptionAkas,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("ID").Transform(idToAkas),
+			},
+
+			// Azure standard columns
+			{
+				Name:        "region",
+				Description: ColumnDescriptionRegion,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Location").Transform(toLower),
+			},
+			{
+				Name:        "resource_group",
+				Description: ColumnDescriptionResourceGroup,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ID").Transform(extractResourceGroupFromID),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listMediaServices(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	session, err := GetNewSession(ctx, d, "MANAGEMENT")
+	if err != nil {
+		return nil, err
+	}
+	subscriptionID := session.SubscriptionID
+
+	client := media.NewMediaservicesClientWithBaseURI(session.ResourceManagerEndpoint, subscriptionID)
+	client.Authorizer = session.Authorizer
+
+	result, err := client.ListBySubscription(ctx)
+	if err != nil {
+		plugin.Logger(ctx).Error("listMediaServices", "list", err)
+		return nil, err
+	}
+
+	for _, service := range result.Values() {
+		d.StreamListItem(ctx, service)
+		// Check if context has been cancelled or if the limit has been hit (if specified)
+		// if there is a limit, it will return the number of rows required to reach this limit
+		if d.RowsRemaining(ctx) == 0 {
+			return nil, nil
+		}
+	}
+
+	for result.NotDone() {
+		err = result.NextWithContext(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("listMediaServices", "list_paging", err)
+			return nil, err
+		}
+		for _, service := range result.Values() {
+			d.StreamListItem(ctx, service)
+			// Check if context has been cancelled or if the limit has been hit (if specified)
+			// if there is a limit, it will return the number of rows required to reach this limit
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, err
+}
+
+//// HYDRATE FUNCTIONS
+
+func getMediaService(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("getMediaService")
+
+	name := d.EqualsQuals["name"].GetStringValue()
+	resourceGroup := d.EqualsQuals["resource_group"].GetStringValue()
+
+	// Handle empty name or resourceGroup
+	if name == "" || resourceGroup == "" {
+		return nil, nil
+	}
+
+	session, err := GetNewSession(ctx, d, "MANAGEMENT")
+	if err != nil {
+		return nil, err
+	}
+	subscriptionID := session.SubscriptionID
+
+	client := media.NewMediaservicesClientWithBaseURI(session.ResourceManagerEndpoint, subscriptionID)
+	client.Authorizer = session.Authorizer
+
+	op, err := client.Get(ctx, resourceGroup, name)
+	if err != nil {
+		plugin.Logger(ctx).Error("getMediaService", "get", err)
+		return nil, err
+	}
+
+	// In some cases resource does not give any notFound error
+	// instead of notFound error, it returns empty data
+	if op.ID != nil {
+		return op, nil
+	}
+
+	return nil, nil
+}
+
+func getMediaServiceProperties(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	service := h.Item.(media.Service)
+
+	session, err := GetNewSession(ctx, d, "MANAGEMENT")
+	if err != nil {
+		return nil, err
+	}
+	subscriptionID := session.SubscriptionID
+
+	client := resources.NewClientWithBaseURI(session.ResourceManagerEndpoint, subscriptionID)
+	client.Authorizer = session.Authorizer
+
+	op, err := client.GetByID(ctx, *service.ID, mediaServiceAPIVersion)
+	if err != nil {
+		plugin.Logger(ctx).Error("getMediaServiceProperties", "get", err)
+		return nil, err
+	}
+
+	return op, nil
+}
+
+func listMediaServicePrivateEndpointConnections(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	service := h.Item.(media.Service)
+	resourceGroup := strings.Split(*service.ID, "/")[4]
+
+	session, err := GetNewSession(ctx, d, "MANAGEMENT")
+	if err != nil {
+		return nil, err
+	}
+	subscriptionID := session.SubscriptionID
+
+	client := media.NewPrivateEndpointConnectionsClientWithBaseURI(session.ResourceManagerEndpoint, subscriptionID)
+	client.Authorizer = session.Authorizer
+
+	op, err := client.List(ctx, resourceGroup, *service.Name)
+	if err != nil {
+		plugin.Logger(ctx).Error("listMediaServicePrivateEndpointConnections", "list", err)
+		return nil, err
+	}
+
+	// If we return the API response directly, the output will not provide
+	// the ID, name and type of the connections
+	var connections []map[string]interface{}
+	if op.Value != nil {
+		for _, connection := range *op.Value {
+			objectMap := make(map[string]interface{})
+			if connection.ID != nil {
+				objectMap["id"] = connection.ID
+			}
+			if connection.Name != nil {
+				objectMap["name"] = connection.Name
+			}
+			if connection.Type != nil {
+				objectMap["type"] = connection.Type
+			}
+			if connection.PrivateEndpointConnectionProperties != nil {
+				objectMap["properties"] = connection.PrivateEndpointConnectionProperties
+			}
+			connections = append(connections, objectMap)
+		}
+	}
+
+	return connections, nil
+}
+
+//// TRANSFORM FUNCTIONS
+
+// The SDK marshaler drops read-only fields such as the storage account mapping status, so build the map manually
+func extractMediaServiceStorageAccounts(ctx context.Context, d *transform.TransformData) (interface{}, error) {
+	data := d.HydrateItem.(media.Service)
+	if data.ServiceProperties == nil || data.ServiceProperties.StorageAccounts == nil {
+		return nil, nil
+	}
+
+	var storageAccounts []map[string]interface{}
+	for _, account := range *data.ServiceProperties.StorageAccounts {
+		objectMap := make(map[string]interface{})
+		if account.ID != nil {
+			objectMap["id"] = account.ID
+		}
+		if account.Type != "" {
+			objectMap["type"] = account.Type
+		}
+		if account.Identity != nil {
+			objectMap["identity"] = account.Identity
+		}
+		if account.Status != nil {
+			objectMap["status"] = account.Status
+		}
+		storageAccounts = append(storageAccounts, objectMap)
+	}
+
+	return storageAccounts, nil
+}
+
+func extractMediaServiceEncryption(ctx context.Context, d *transform.TransformData) (interface{}, error) {
+	data := d.HydrateItem.(media.Service)
+	if data.ServiceProperties == nil || data.ServiceProperties.Encryption == nil {
+		return nil, nil
+	}
+
+	encryption := data.ServiceProperties.Encryption
+	objectMap := make(map[string]interface{})
+	if encryption.Type != "" {
+		objectMap["type"] = encryption.Type
+	}
+	if encryption.KeyVaultProperties != nil {
+		keyVaultProperties := make(map[string]interface{})
+		if encryption.KeyVaultProperties.KeyIdentifier != nil {
+			keyVaultProperties["keyIdentifier"] = encryption.KeyVaultProperties.KeyIdentifier
+		}
+		if encryption.KeyVaultProperties.CurrentKeyIdentifier != nil {
+			keyVaultProperties["currentKeyIdentifier"] = encryption.KeyVaultProperties.CurrentKeyIdentifier
+		}
+		objectMap["keyVaultProperties"] = keyVaultProperties
+	}
+	if encryption.Identity != nil {
+		objectMap["identity"] = encryption.Identity
+	}
+	if encryption.Status != nil {
+		objectMap["status"] = encryption.Status
+	}
+
+	return objectMap, nil
+}
+
+func extractMediaServiceIdentity(ctx context.Context, d *transform.TransformData) (interface{}, error) {
+	data := d.HydrateItem.(media.Service)
+	if data.Identity == nil {
+		return nil, nil
+	}
+
+	objectMap := make(map[string]interface{})
+	if data.Identity.Type != nil {
+		objectMap["type"] = data.Identity.Type
+	}
+	if data.Identity.PrincipalID != nil {
+		objectMap["principalId"] = data.Identity.PrincipalID
+	}
+	if data.Identity.TenantID != nil {
+		objectMap["tenantId"] = data.Identity.TenantID
+	}
+	if data.Identity.UserAssignedIdentities != nil {
+		objectMap["userAssignedIdentities"] = data.Identity.UserAssignedIdentities
+	}
+
+	return objectMap, nil
+}
@@ -0,0 +1,276 @@
+package azure
+
+import (
+	"context"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/profiles/latest/resourcegraph/mgmt/resourcegraph"
+	"github.com/Azure/azure-sdk-for-go/profiles/preview/preview/monitor/mgmt/insights"
+	"github.com/turbot/go-kit/types"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+)
+
+type MonitorDiagnosticSettingInfo = struct {
+	insights.DiagnosticSettingsResource
+	ResourceID *string
+}
+
+//// TABLE DEFINITION
+
+func tableAzureMonitorDiagnosticSetting(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "azure_monitor_diagnostic_setting",
+		Description: "Azure Monitor Diagnostic Setting",
+		List: &plugin.ListConfig{
+			Hydrate: listMonitorDiagnosticSettings,
+			KeyColumns: plugin.KeyColumnSlice{
+				{Name: "resource_id", Require: plugin.Optional},
+			},
+		},
+		Columns: azureColumns([]*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name of the diagnostic setting.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "id",
+				Description: "The ID of the diagnostic setting.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromGo(),
+			},
+			{
+				Name:        "type",
+				Description: "The type of the resource.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "resource_id",
+				Description: "The ID of the monitored resource.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ResourceID"),
+			},
+			{
+				Name:        "resource_type",
+				Description: "The type of the monitored resource, e.g. Microsoft.KeyVault/vaults.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ResourceID").Transform(extractResourceTypeFromID),
+			},
+			{
+				Name:        "storage_account_id",
+				Description: "The resource ID of the storage account to which diagnostic logs are sent.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("DiagnosticSettings.StorageAccountID"),
+			},
+			{
+				Name:        "workspace_id",
+				Description: "The resource ID of the Log Analytics workspace to which diagnostic logs are sent.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("DiagnosticSettings.WorkspaceID"),
+			},
+			{
+				Name:        "log_analytics_destination_type",
+				Description: "Whether the export to Log Analytics uses the default destination type, i.e. AzureDiagnostics, or a resource specific destination type.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("DiagnosticSettings.LogAnalyticsDestinationType"),
+			},
+			{
+				Name:        "event_hub_authorization_rule_id",
+				Description: "The resource ID of the event hub authorization rule.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("DiagnosticSettings.EventHubAuthorizationRuleID"),
+			},
+			{
+				Name:        "event_hub_name",
+				Description: "The name of the event hub. If none is specified, the default event hub is selected.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("DiagnosticSettings.EventHubName"),
+			},
+			{
+				Name:        "service_bus_rule_id",
+				Description: "The service bus rule ID of the diagnostic setting.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("DiagnosticSettings.ServiceBusRuleID"),
+			},
+			{
+				Name:        "metrics",
+				Description: "The list of metric settings.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("DiagnosticSettings.Metrics"),
+			},
+			{
+				Name:        "logs",
+				Description: "The list of log settings.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("DiagnosticSettings.Logs"),
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+			{
+				Name:        "akas",
+				Description: ColumnDescriptionAkas,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("ID").Transform(idToAkas),
+			},
+
+			// Azure standard columns
+			{
+				Name:        "resource_group",
+				Description: ColumnDescriptionResourceGroup,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ResourceID").Transform(extractResourceGroupFromID),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listMonitorDiagnosticSettings(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	session, err := GetNewSession(ctx, d, "MANAGEMENT")
+	if err != nil {
+		return nil, err
+	}
+	subscriptionID := session.SubscriptionID
+
+	client := insights.NewDiagnosticSettingsClientWithBaseURI(session.ResourceManagerEndpoint, subscriptionID)
+	client.Authorizer = session.Authorizer
+
+	// Only look up the diagnostic settings of the given resource
+	resourceID := d.EqualsQuals["resource_id"].GetStringValue()
+	if resourceID != "" {
+		_, err := streamMonitorDiagnosticSettings(ctx, d, client, resourceID)
+		return nil, err
+	}
+
+	graphClient := resourcegraph.NewWithBaseURI(session.ResourceManagerEndpoint)
+	graphClient.Authorizer = session.Authorizer
+
+	// Diagnostic settings are extension resources, so the monitored resources
+	// are enumerated with Azure Resource Graph first
+	query := "resources | project id | order by id asc"
+	top := int32(1000)
+	request := resourcegraph.QueryRequest{
+		Subscriptions: &[]string{subscriptionID},
+		Query:         &query,
+		Options: &resourcegraph.QueryRequestOptions{
+			Top:          &top,
+			ResultFormat: resourcegraph.ResultFormatObjectArray,
+		},
+	}
+
+	for {
+		result, err := graphClient.Resources(ctx, request)
+		if err != nil {
+			plugin.Logger(ctx).Error("listMonitorDiagnosticSettings", "query", err)
+			return nil, err
+		}
+
+		if rows, ok := result.Data.([]interface{}); ok {
+			for _, row := range rows {
+				resource, ok := row.(map[string]interface{})
+				if !ok {
+					continue
+				}
+				id, ok := resource["id"].(string)
+				if !ok || id == "" {
+					continue
+				}
+
+				done, err := streamMonitorDiagnosticSettings(ctx, d, client, id)
+				if err != nil {
+					return nil, err
+				}
+				if done {
+					return nil, nil
+				}
+			}
+		}
+
+		if result.SkipToken == nil || *result.SkipToken == "" {
+			break
+		}
+		request.Options.SkipToken = result.SkipToken
+	}
+
+	return nil, nil
+}
+
+// streamMonitorDiagnosticSettings streams the diagnostic settings of a resource and
+// reports whether the query limit has been reached
+func streamMonitorDiagnosticSettings(ctx context.Context, d *plugin.QueryData, client insights.DiagnosticSettingsClient, resourceID string) (bool, error) {
+	result, err := client.List(ctx, resourceID)
+	if err != nil {
+		// Not every resource type supports diagnostic settings, and resources
+		// may have been deleted since they were enumerated. Skip them so that
+		// a single resource does not fail the whole scan
+		if isDiagnosticSettingsUnsupportedError(err) {
+			plugin.Logger(ctx).Debug("listMonitorDiagnosticSettings", "skipping_resource", resourceID, "error", err)
+			return false, nil
+		}
+		plugin.Logger(ctx).Error("listMonitorDiagnosticSettings", "list", err)
+		return false, err
+	}
+
+	if result.Value == nil {
+		return false, nil
+	}
+
+	for _, setting := range *result.Value {
+		d.StreamListItem(ctx, MonitorDiagnosticSettingInfo{setting, &resourceID})
+		// Check if context has been cancelled or if the limit has been hit (if specified)
+		// if there is a limit, it will return the number of rows required to reach this limit
+		if d.RowsRemaining(ctx) == 0 {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+// isDiagnosticSettingsUnsupportedError reports whether the diagnostic settings
+// of a resource could not be listed because its type does not support them or
+// the resource no longer exists. Unsupported types return either
+// ResourceTypeNotSupported or a BadRequest error
+func isDiagnosticSettingsUnsupportedError(err error) bool {
+	for _, code := range []string{"ResourceTypeNotSupported", "BadRequest", "ResourceNotFound", "StatusCode=400", "StatusCode=404"} {
+		if strings.Contains(err.Error(), code) {
+			return true
+		}
+	}
+	return false
+}
+
+//// TRANSFORM FUNCTIONS
+
+// extractResourceTypeFromID returns the type of a resource from its ID, e.g.
+// Microsoft.Web/sites/slots for .../providers/Microsoft.Web/sites/app/slots/staging
+func extractResourceTypeFromID(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	id := types.SafeString(d.Value)
+	if id == "" {
+		return nil, nil
+	}
+
+	parts := strings.Split(strings.Trim(id, "/"), "/")
+	for i := len(parts) - 1; i >= 0; i-- {
+		if !strings.EqualFold(parts[i], "providers") || i+2 >= len(parts) {
+			continue
+		}
+		resourceType := parts[i+1]
+		for j := i + 2; j < len(parts); j += 2 {
+			resourceType += "/" + parts[j]
+		}
+		return resourceType, nil
+	}
+
+	return nil, nil
+}
@@ -0,0 +1,293 @@
+package azure
+
+import (
+	"context"
+
+	"github.com/Azure/azure-sdk-for-go/profiles/preview/preview/monitor/mgmt/insights"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+)
+
+//// TABLE DEFINITION
+
+func tableAzureMonitorScheduledQueryRule(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "azure_monitor_scheduled_query_rule",
+		Description: "Azure Monitor Scheduled Query Rule",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.AllColumns([]string{"name", "resource_group"}),
+			Hydrate:    getMonitorScheduledQueryRule,
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: isNotFoundError([]string{"ResourceNotFound", "ResourceGroupNotFound", "404"}),
+			},
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listMonitorScheduledQueryRules,
+		},
+		Columns: azureColumns([]*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name of the scheduled query rule.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "id",
+				Description: "The resource ID of the scheduled query rule.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromGo(),
+			},
+			{
+				Name:        "type",
+				Description: "The type of the resource.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "display_name",
+				Description: "The display name of the alert rule.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ScheduledQueryRuleProperties.DisplayName"),
+			},
+			{
+				Name:        "description",
+				Description: "The description of the scheduled query rule.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ScheduledQueryRuleProperties.Description"),
+			},
+			{
+				Name:        "kind",
+				Description: "Indicates the type of scheduled query rule. Possible values include: 'LogAlert', 'LogToMetric'.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "severity",
+				Description: "Severity of the alert. Should be an integer between [0-4]. Value of 0 is severest.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("ScheduledQueryRuleProperties.Severity"),
+			},
+			{
+				Name:        "enabled",
+				Description: "Indicates whether this scheduled query rule is enabled.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("ScheduledQueryRuleProperties.Enabled"),
+			},
+			{
+				Name:        "evaluation_frequency",
+				Description: "How often the scheduled query rule is evaluated represented in ISO 8601 duration format.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ScheduledQueryRuleProperties.EvaluationFrequency"),
+			},
+			{
+				Name:        "window_size",
+				Description: "The period of time (in ISO 8601 duration format) on which the alert query will be executed (bin size).",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ScheduledQueryRuleProperties.WindowSize"),
+			},
+			{
+				Name:        "override_query_time_range",
+				Description: "If specified then overrides the query time range (default is WindowSize*NumberOfEvaluationPeriods).",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ScheduledQueryRuleProperties.OverrideQueryTimeRange"),
+			},
+			{
+				Name:        "mute_actions_duration",
+				Description: "Mute actions for the chosen period of time (in ISO 8601 duration format) after the alert is fired.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ScheduledQueryRuleProperties.MuteActionsDuration"),
+			},
+			{
+				Name:        "auto_mitigate",
+				Description: "Indicates whether the alert should be automatically resolved or not.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("ScheduledQueryRuleProperties.AutoMitigate"),
+			},
+			{
+				Name:        "check_workspace_alerts_storage_configured",
+				Description: "Indicates whether this scheduled query rule should be stored in the customer's storage.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("ScheduledQueryRuleProperties.CheckWorkspaceAlertsStorageConfigured"),
+			},
+			{
+				Name:        "is_workspace_alerts_storage_configured",
+				Description: "Indicates whether this scheduled query rule has been configured to be stored in the customer's storage.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("ScheduledQueryRuleProperties.IsWorkspaceAlertsStorageConfigured"),
+			},
+			{
+				Name:        "skip_query_validation",
+				Description: "Indicates whether the provided query should be validated or not.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("ScheduledQueryRuleProperties.SkipQueryValidation"),
+			},
+			{
+				Name:        "created_with_api_version",
+				Description: "The api-version used when creating this alert rule.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ScheduledQueryRuleProperties.CreatedWithAPIVersion"),
+			},
+			{
+				Name:        "is_legacy_log_analytics_rule",
+				Description: "True if alert rule is legacy Log Analytic rule.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("ScheduledQueryRuleProperties.IsLegacyLogAnalyticsRule"),
+			},
+			{
+				Name:        "etag",
+				Description: "An unique read-only string that changes whenever the resource is updated.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "scopes",
+				Description: "The list of resource IDs that this scheduled query rule is scoped to.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("ScheduledQueryRuleProperties.Scopes"),
+			},
+			{
+				Name:        "criteria",
+				Description: "The rule criteria that defines the conditions of the scheduled query rule.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("ScheduledQueryRuleProperties.Criteria"),
+			},
+			{
+				Name:        "target_resource_types",
+				Description: "List of resource type of the target resource(s) on which the alert is created/updated.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("ScheduledQueryRuleProperties.TargetResourceTypes"),
+			},
+			{
+				Name:        "action_groups",
+				Description: "Action group resource IDs to invoke when the alert fires.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("ScheduledQueryRuleProperties.Actions.ActionGroups"),
+			},
+			{
+				Name:        "action_custom_properties",
+				Description: "The properties of an alert payload.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("ScheduledQueryRuleProperties.Actions.CustomProperties"),
+			},
+			{
+				Name:        "system_data",
+				Description: "Metadata pertaining to creation and last modification of the resource.",
+				Type:        proto.ColumnType_JSON,
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+			{
+				Name:        "tags",
+				Description: ColumnDescriptionTags,
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "akas",
+				Description: ColumnDescriptionAkas,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("ID").Transform(idToAkas),
+			},
+
+			// Azure standard columns
+			{
+				Name:        "region",
+				Description: ColumnDescriptionRegion,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Location").Transform(toLower),
+			},
+			{
+				Name:        "resource_group",
+				Description: ColumnDescriptionResourceGroup,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ID").Transform(extractResourceGroupFromID),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listMonitorScheduledQueryRules(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	session, err := GetNewSession(ctx, d, "MANAGEMENT")
+	if err != nil {
+		return nil, err
+	}
+	subscriptionID := session.SubscriptionID
+
+	client := insights.NewScheduledQueryRulesClientWithBaseURI(session.ResourceManagerEndpoint, subscriptionID)
+	client.Authorizer = session.Authorizer
+
+	result, err := client.ListBySubscription(ctx)
+	if err != nil {
+		plugin.Logger(ctx).Error("listMonitorScheduledQueryRules", "list", err)
+		return nil, err
+	}
+
+	for _, rule := range result.Values() {
+		d.StreamListItem(ctx, rule)
+		// Check if context has been cancelled or if the limit has been hit (if specified)
+		// if there is a limit, it will return the number of rows required to reach this limit
+		if d.RowsRemaining(ctx) == 0 {
+			return nil, nil
+		}
+	}
+
+	for result.NotDone() {
+		err = result.NextWithContext(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("listMonitorScheduledQueryRules", "list_paging", err)
+			return nil, err
+		}
+		for _, rule := range result.Values() {
+			d.StreamListItem(ctx, rule)
+			// Check if context has been cancelled or if the limit has been hit (if specified)
+			// if there is a limit, it will return the number of rows required to reach this limit
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, err
+}
+
+//// HYDRATE FUNCTIONS
+
+func getMonitorScheduledQueryRule(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("getMonitorScheduledQueryRule")
+
+	name := d.EqualsQuals["name"].GetStringValue()
+	resourceGroup := d.EqualsQuals["resource_group"].GetStringValue()
+
+	// Handle empty name or resourceGroup
+	if name == "" || resourceGroup == "" {
+		return nil, nil
+	}
+
+	session, err := GetNewSession(ctx, d, "MANAGEMENT")
+	if err != nil {
+		return nil, err
+	}
+	subscriptionID := session.SubscriptionID
+
+	client := insights.NewScheduledQueryRulesClientWithBaseURI(session.ResourceManagerEndpoint, subscriptionID)
+	client.Authorizer = session.Authorizer
+
+	op, err := client.Get(ctx, resourceGroup, name)
+	if err != nil {
+		plugin.Logger(ctx).Error("getMonitorScheduledQueryRule", "get", err)
+		return nil, err
+	}
+
+	// In some cases resource does not give any notFound error
+	// instead of notFound error, it returns empty data
+	if op.ID != nil {
+		return op, nil
+	}
+
+	return nil, nil
+}
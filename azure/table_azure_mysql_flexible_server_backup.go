@@ -0,0 +1,228 @@
+package azure
+
+import (
+	"context"
+
+	"github.com/Azure/azure-sdk-for-go/profiles/latest/mysql/mgmt/mysqlflexibleservers"
+	"github.com/Azure/azure-sdk-for-go/profiles/latest/resources/mgmt/resources"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+)
+
+//// TABLE DEFINITION
+
+func tableAzureMySQLFlexibleServerBackup(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "azure_mysql_flexible_server_backup",
+		Description: "Azure MySQL Flexible Server Backup",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.AllColumns([]string{"server_name", "name", "resource_group"}),
+			Hydrate:    getMySQLFlexibleServerBackup,
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: isNotFoundError([]string{"ResourceNotFound", "ResourceGroupNotFound", "404"}),
+			},
+		},
+		List: &plugin.ListConfig{
+			// The flexible servers are listed per resource group, so the backups are too
+			ParentHydrate: listResourceGroups,
+			Hydrate:       listMySQLFlexibleServerBackups,
+		},
+		Columns: azureColumns([]*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name of the backup.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "id",
+				Description: "The ID of the backup.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromGo(),
+			},
+			{
+				Name:        "server_name",
+				Description: "The name of the server the backup belongs to.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "type",
+				Description: "The type of the resource.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "backup_type",
+				Description: "The type of the backup, e.g. FULL.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ServerBackupProperties.BackupType"),
+			},
+			{
+				Name:        "completion_time",
+				Description: "The time when the backup completed.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("ServerBackupProperties.CompletedTime").Transform(convertDateToTime),
+			},
+			{
+				Name:        "source",
+				Description: "The source of the backup, e.g. Automatic.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ServerBackupProperties.Source"),
+			},
+			{
+				Name:        "system_data",
+				Description: "The system metadata relating to the backup.",
+				Type:        proto.ColumnType_JSON,
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+			{
+				Name:        "akas",
+				Description: ColumnDescriptionAkas,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("ID").Transform(idToAkas),
+			},
+
+			// Azure standard columns
+			{
+				Name:        "region",
+				Description: ColumnDescriptionRegion,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Location").Transform(toLower),
+			},
+			{
+				Name:        "resource_group",
+				Description: ColumnDescriptionResourceGroup,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ID").Transform(extractResourceGroupFromID),
+			},
+		}),
+	}
+}
+
+type MySQLFlexibleServerBackupInfo = struct {
+	mysqlflexibleservers.ServerBackup
+	ServerName *string
+	Location   *string
+}
+
+//// LIST FUNCTION
+
+func listMySQLFlexibleServerBackups(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	resourceGroupName := h.Item.(resources.Group).Name
+
+	session, err := GetNewSession(ctx, d, "MANAGEMENT")
+	if err != nil {
+		return nil, err
+	}
+	subscriptionID := session.SubscriptionID
+
+	serverClient := mysqlflexibleservers.NewServersClientWithBaseURI(session.ResourceManagerEndpoint, subscriptionID)
+	serverClient.Authorizer = session.Authorizer
+
+	client := mysqlflexibleservers.NewBackupsClientWithBaseURI(session.ResourceManagerEndpoint, subscriptionID)
+	client.Authorizer = session.Authorizer
+
+	servers, err := serverClient.ListByResourceGroupComplete(ctx, *resourceGroupName)
+	if err != nil {
+		plugin.Logger(ctx).Error("listMySQLFlexibleServerBackups", "list_servers", err)
+		return nil, err
+	}
+
+	for servers.NotDone() {
+		server := servers.Value()
+
+		result, err := client.ListByServer(ctx, *resourceGroupName, *server.Name)
+		if err != nil {
+			plugin.Logger(ctx).Error("listMySQLFlexibleServerBackups", "list", err)
+			return nil, err
+		}
+
+		for _, backup := range result.Values() {
+			d.StreamListItem(ctx, MySQLFlexibleServerBackupInfo{backup, server.Name, server.Location})
+			// Check if context has been cancelled or if the limit has been hit (if specified)
+			// if there is a limit, it will return the number of rows required to reach this limit
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+
+		for result.NotDone() {
+			err = result.NextWithContext(ctx)
+			if err != nil {
+				plugin.Logger(ctx).Error("listMySQLFlexibleServerBackups", "list_paging", err)
+				return nil, err
+			}
+			for _, backup := range result.Values() {
+				d.StreamListItem(ctx, MySQLFlexibleServerBackupInfo{backup, server.Name, server.Location})
+				// Check if context has been cancelled or if the limit has been hit (if specified)
+				// if there is a limit, it will return the number of rows required to reach this limit
+				if d.RowsRemaining(ctx) == 0 {
+					return nil, nil
+				}
+			}
+		}
+
+		err = servers.NextWithContext(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("listMySQLFlexibleServerBackups", "list_servers_paging", err)
+			return nil, err
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getMySQLFlexibleServerBackup(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("getMySQLFlexibleServerBackup")
+
+	serverName := d.EqualsQualString("server_name")
+	name := d.EqualsQualString("name")
+	resourceGroup := d.EqualsQualString("resource_group")
+
+	// Handle empty check
+	if serverName == "" || name == "" || resourceGroup == "" {
+		return nil, nil
+	}
+
+	session, err := GetNewSession(ctx, d, "MANAGEMENT")
+	if err != nil {
+		return nil, err
+	}
+	subscriptionID := session.SubscriptionID
+
+	client := mysqlflexibleservers.NewBackupsClientWithBaseURI(session.ResourceManagerEndpoint, subscriptionID)
+	client.Authorizer = session.Authorizer
+
+	op, err := client.Get(ctx, resourceGroup, serverName, name)
+	if err != nil {
+		plugin.Logger(ctx).Error("getMySQLFlexibleServerBackup", "get", err)
+		return nil, err
+	}
+
+	// In some cases resource does not give any notFound error
+	// instead of notFound error, it returns empty data
+	if op.ID == nil {
+		return nil, nil
+	}
+
+	// The backup does not return the location, so it is taken from the server
+	serverClient := mysqlflexibleservers.NewServersClientWithBaseURI(session.ResourceManagerEndpoint, subscriptionID)
+	serverClient.Authorizer = session.Authorizer
+
+	server, err := serverClient.Get(ctx, resourceGroup, serverName)
+	if err != nil {
+		plugin.Logger(ctx).Error("getMySQLFlexibleServerBackup", "get_server", err)
+		return nil, err
+	}
+
+	return MySQLFlexibleServerBackupInfo{op, server.Name, server.Location}, nil
+}
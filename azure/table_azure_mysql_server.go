@@ -207,6 +207,13 @@ func tableAzureMySQLServer(_ context.Context) *plugin.Table {
 				Hydrate:     listMySQLServersConfigurations,
 				Transform:   transform.FromValue(),
 			},
+			{
+				Name:        "firewall_rules",
+				Description: "A list of firewall rules for a server.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     listMySQLServerFirewallRules,
+				Transform:   transform.FromValue(),
+			},
 			{
 				Name:        "server_keys",
 				Description: "The server keys of the server.",
@@ -437,6 +444,38 @@ func listMySQLServersConfigurations(ctx context.Context, d *plugin.QueryData, h
 	return mySQLServersConfigurations, nil
 }
 
+func listMySQLServerFirewallRules(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	server := h.Item.(mysql.Server)
+	resourceGroup := strings.Split(string(*server.ID), "/")[4]
+	serverName := *server.Name
+
+	session, err := GetNewSession(ctx, d, "MANAGEMENT")
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_mysql_server.listMySQLServerFirewallRules", "connection_error", err)
+		return nil, err
+	}
+	subscriptionID := session.SubscriptionID
+
+	client := mysql.NewFirewallRulesClientWithBaseURI(session.ResourceManagerEndpoint, subscriptionID)
+	client.Authorizer = session.Authorizer
+
+	op, err := client.ListByServer(ctx, resourceGroup, serverName)
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_mysql_server.listMySQLServerFirewallRules", "api_error", err)
+		return nil, err
+	}
+
+	var firewallRules []map[string]interface{}
+
+	if op.Value != nil {
+		for _, i := range *op.Value {
+			firewallRules = append(firewallRules, extractMySQLServerFirewallRule(i))
+		}
+	}
+
+	return firewallRules, nil
+}
+
 func getMySQLServerSecurityAlertPolicy(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
 	plugin.Logger(ctx).Debug("getMySQLServerSecurityAlertPolicy")
 
@@ -549,3 +588,23 @@ func extractMySQLServersconfiguration(i mysql.Configuration) map[string]interfac
 
 	return mySQLServersconfiguration
 }
+
+// If we return the API response directly, the output will not provide the properties of FirewallRules
+func extractMySQLServerFirewallRule(i mysql.FirewallRule) map[string]interface{} {
+	mySQLServerFirewallRule := make(map[string]interface{})
+
+	if i.ID != nil {
+		mySQLServerFirewallRule["ID"] = *i.ID
+	}
+	if i.Name != nil {
+		mySQLServerFirewallRule["Name"] = *i.Name
+	}
+	if i.Type != nil {
+		mySQLServerFirewallRule["Type"] = *i.Type
+	}
+	if i.FirewallRuleProperties != nil {
+		mySQLServerFirewallRule["FirewallRuleProperties"] = *i.FirewallRuleProperties
+	}
+
+	return mySQLServerFirewallRule
+}
@@ -0,0 +1,192 @@
+package azure
+
+import (
+	"context"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/profiles/latest/mysql/mgmt/mysql"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+)
+
+//// TABLE DEFINITION
+
+func tableAzureMySQLServerKey(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "azure_mysql_server_key",
+		Description: "Azure MySQL Server Key",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.AllColumns([]string{"server_name", "name", "resource_group"}),
+			Hydrate:    getMySQLServerKey,
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: isNotFoundError([]string{"ResourceNotFound", "ResourceGroupNotFound", "NotFound", "404"}),
+			},
+		},
+		List: &plugin.ListConfig{
+			Hydrate:       listMySQLServerKeys,
+			ParentHydrate: listMySQLServers,
+		},
+		Columns: azureColumns([]*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name of the server key, in the format '{vaultName}_{keyName}_{keyVersion}'.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "id",
+				Description: "The resource ID of the server key.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromGo(),
+			},
+			{
+				Name:        "server_name",
+				Description: "The name of the server the key belongs to.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "type",
+				Description: "The type of the resource.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "kind",
+				Description: "Kind of encryption protector used to protect the key.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "server_key_type",
+				Description: "The key type like 'AzureKeyVault'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ServerKeyProperties.ServerKeyType"),
+			},
+			{
+				Name:        "uri",
+				Description: "The URI of the key.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ServerKeyProperties.URI"),
+			},
+			{
+				Name:        "creation_date",
+				Description: "The key creation date.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("ServerKeyProperties.CreationDate").Transform(convertDateToTime),
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+			{
+				Name:        "akas",
+				Description: ColumnDescriptionAkas,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("ID").Transform(idToAkas),
+			},
+
+			// Azure standard columns
+			{
+				Name:        "resource_group",
+				Description: ColumnDescriptionResourceGroup,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ID").Transform(extractResourceGroupFromID),
+			},
+		}),
+	}
+}
+
+type MySQLServerKeyInfo = struct {
+	mysql.ServerKey
+	ServerName *string
+}
+
+//// LIST FUNCTION
+
+func listMySQLServerKeys(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	server := h.Item.(mysql.Server)
+	resourceGroup := strings.Split(*server.ID, "/")[4]
+
+	session, err := GetNewSession(ctx, d, "MANAGEMENT")
+	if err != nil {
+		return nil, err
+	}
+	subscriptionID := session.SubscriptionID
+
+	client := mysql.NewServerKeysClientWithBaseURI(session.ResourceManagerEndpoint, subscriptionID)
+	client.Authorizer = session.Authorizer
+
+	result, err := client.List(ctx, resourceGroup, *server.Name)
+	if err != nil {
+		plugin.Logger(ctx).Error("listMySQLServerKeys", "list", err)
+		return nil, err
+	}
+
+	for _, key := range result.Values() {
+		d.StreamListItem(ctx, MySQLServerKeyInfo{key, server.Name})
+		// Check if context has been cancelled or if the limit has been hit (if specified)
+		// if there is a limit, it will return the number of rows required to reach this limit
+		if d.RowsRemaining(ctx) == 0 {
+			return nil, nil
+		}
+	}
+
+	for result.NotDone() {
+		err = result.NextWithContext(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("listMySQLServerKeys", "list_paging", err)
+			return nil, err
+		}
+		for _, key := range result.Values() {
+			d.StreamListItem(ctx, MySQLServerKeyInfo{key, server.Name})
+			// Check if context has been cancelled or if the limit has been hit (if specified)
+			// if there is a limit, it will return the number of rows required to reach this limit
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, err
+}
+
+//// HYDRATE FUNCTIONS
+
+func getMySQLServerKey(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("getMySQLServerKey")
+
+	serverName := d.EqualsQuals["server_name"].GetStringValue()
+	name := d.EqualsQuals["name"].GetStringValue()
+	resourceGroup := d.EqualsQuals["resource_group"].GetStringValue()
+
+	// Handle empty check
+	if serverName == "" || name == "" || resourceGroup == "" {
+		return nil, nil
+	}
+
+	session, err := GetNewSession(ctx, d, "MANAGEMENT")
+	if err != nil {
+		return nil, err
+	}
+	subscriptionID := session.SubscriptionID
+
+	client := mysql.NewServerKeysClientWithBaseURI(session.ResourceManagerEndpoint, subscriptionID)
+	client.Authorizer = session.Authorizer
+
+	op, err := client.Get(ctx, resourceGroup, serverName, name)
+	if err != nil {
+		plugin.Logger(ctx).Error("getMySQLServerKey", "get", err)
+		return nil, err
+	}
+
+	// In some cases resource does not give any notFound error
+	// instead of notFound error, it returns empty data
+	if op.ID != nil {
+		return MySQLServerKeyInfo{op, &serverName}, nil
+	}
+
+	return nil, nil
+}
@@ -0,0 +1,247 @@
+package azure
+
+import (
+	"context"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/profiles/latest/network/mgmt/network"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+)
+
+type connectionMonitorInfo = struct {
+	network.ConnectionMonitorResult
+	NetworkWatcherName string
+}
+
+//// TABLE DEFINITION
+
+func tableAzureNetworkConnectionMonitor(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "azure_network_connection_monitor",
+		Description: "Azure Network Watcher Connection Monitor",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.AllColumns([]string{"network_watcher_name", "name", "resource_group"}),
+			Hydrate:    getNetworkConnectionMonitor,
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: isNotFoundError([]string{"ResourceNotFound", "ResourceGroupNotFound", "404"}),
+			},
+		},
+		List: &plugin.ListConfig{
+			Hydrate:       listNetworkConnectionMonitors,
+			ParentHydrate: listNetworkWatchers,
+		},
+		Columns: azureColumns([]*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name of the connection monitor.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "id",
+				Description: "The ID of the connection monitor.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromGo(),
+			},
+			{
+				Name:        "network_watcher_name",
+				Description: "The friendly name that identifies the network watcher.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "type",
+				Description: "The type of the resource.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "etag",
+				Description: "A unique read-only string that changes whenever the resource is updated.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "provisioning_state",
+				Description: "The provisioning state of the connection monitor. Possible values include: 'Succeeded', 'Updating', 'Deleting', 'Failed'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ConnectionMonitorResultProperties.ProvisioningState"),
+			},
+			{
+				Name:        "start_time",
+				Description: "The date and time when the connection monitor was started.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("ConnectionMonitorResultProperties.StartTime").Transform(convertDateToTime),
+			},
+			{
+				Name:        "monitoring_status",
+				Description: "The monitoring status of the connection monitor.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ConnectionMonitorResultProperties.MonitoringStatus"),
+			},
+			{
+				Name:        "connection_monitor_type",
+				Description: "The type of the connection monitor. Possible values include: 'MultiEndpoint', 'SingleSourceDestination'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ConnectionMonitorResultProperties.ConnectionMonitorType"),
+			},
+			{
+				Name:        "notes",
+				Description: "Optional notes associated with the connection monitor.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ConnectionMonitorResultProperties.Notes"),
+			},
+			{
+				Name:        "auto_start",
+				Description: "Indicates whether the connection monitor starts automatically once created.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("ConnectionMonitorResultProperties.AutoStart"),
+			},
+			{
+				Name:        "monitoring_interval_in_seconds",
+				Description: "The monitoring interval of a classic connection monitor, in seconds.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("ConnectionMonitorResultProperties.MonitoringIntervalInSeconds"),
+			},
+			{
+				Name:        "source",
+				Description: "The source of a classic connection monitor.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("ConnectionMonitorResultProperties.Source"),
+			},
+			{
+				Name:        "destination",
+				Description: "The destination of a classic connection monitor.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("ConnectionMonitorResultProperties.Destination"),
+			},
+			{
+				Name:        "endpoints",
+				Description: "The list of endpoints, such as virtual machines, virtual networks, subnets or external addresses, monitored by the connection monitor.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("ConnectionMonitorResultProperties.Endpoints"),
+			},
+			{
+				Name:        "test_configurations",
+				Description: "The list of test configurations, with their protocol, port, test frequency and success threshold.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("ConnectionMonitorResultProperties.TestConfigurations"),
+			},
+			{
+				Name:        "test_groups",
+				Description: "The list of test groups, which combine sources, destinations and test configurations.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("ConnectionMonitorResultProperties.TestGroups"),
+			},
+			{
+				Name:        "outputs",
+				Description: "The list of outputs, such as the Log Analytics workspaces the test results are sent to.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("ConnectionMonitorResultProperties.Outputs"),
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+			{
+				Name:        "tags",
+				Description: ColumnDescriptionTags,
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "akas",
+				Description: ColumnDescriptionAkas,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("ID").Transform(idToAkas),
+			},
+
+			// Azure standard columns
+			{
+				Name:        "region",
+				Description: ColumnDescriptionRegion,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Location").Transform(toLower),
+			},
+			{
+				Name:        "resource_group",
+				Description: ColumnDescriptionResourceGroup,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ID").Transform(extractResourceGroupFromID),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listNetworkConnectionMonitors(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	// Get the details of network watcher
+	networkWatcherDetails := h.Item.(network.Watcher)
+
+	// Create session
+	session, err := GetNewSession(ctx, d, "MANAGEMENT")
+	if err != nil {
+		return nil, err
+	}
+	subscriptionID := session.SubscriptionID
+	resourceGroupID := strings.Split(*networkWatcherDetails.ID, "/")[4]
+
+	client := network.NewConnectionMonitorsClientWithBaseURI(session.ResourceManagerEndpoint, subscriptionID)
+	client.Authorizer = session.Authorizer
+
+	result, err := client.List(ctx, resourceGroupID, *networkWatcherDetails.Name)
+	if err != nil {
+		plugin.Logger(ctx).Error("listNetworkConnectionMonitors", "list", err)
+		return nil, err
+	}
+
+	if result.Value == nil {
+		return nil, nil
+	}
+
+	for _, connectionMonitor := range *result.Value {
+		d.StreamListItem(ctx, connectionMonitorInfo{connectionMonitor, *networkWatcherDetails.Name})
+		// Check if context has been cancelled or if the limit has been hit (if specified)
+		// if there is a limit, it will return the number of rows required to reach this limit
+		if d.RowsRemaining(ctx) == 0 {
+			return nil, nil
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getNetworkConnectionMonitor(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("getNetworkConnectionMonitor")
+
+	networkWatcherName := d.EqualsQuals["network_watcher_name"].GetStringValue()
+	name := d.EqualsQuals["name"].GetStringValue()
+	resourceGroup := d.EqualsQuals["resource_group"].GetStringValue()
+
+	// Handle empty check
+	if networkWatcherName == "" || name == "" || resourceGroup == "" {
+		return nil, nil
+	}
+
+	session, err := GetNewSession(ctx, d, "MANAGEMENT")
+	if err != nil {
+		return nil, err
+	}
+	subscriptionID := session.SubscriptionID
+
+	client := network.NewConnectionMonitorsClientWithBaseURI(session.ResourceManagerEndpoint, subscriptionID)
+	client.Authorizer = session.Authorizer
+
+	op, err := client.Get(ctx, resourceGroup, networkWatcherName, name)
+	if err != nil {
+		plugin.Logger(ctx).Error("getNetworkConnectionMonitor", "get", err)
+		return nil, err
+	}
+
+	return connectionMonitorInfo{op, networkWatcherName}, nil
+}
@@ -27,6 +27,13 @@ func tableAzureNetworkSecurityGroup(_ context.Context) *plugin.Table {
 		List: &plugin.ListConfig{
 			Hydrate: listNetworkSecurityGroups,
 		},
+		HydrateConfig: []plugin.HydrateConfig{
+			{
+				Func:           listNetworkSecurityGroupDiagnosticSettings,
+				MaxConcurrency: diagnosticSettingsMaxConcurrency,
+				Tags:           map[string]string{"service": "Microsoft.Insights", "action": "diagnosticSettings/list"},
+			},
+		},
 		Columns: azureColumns([]*plugin.Column{
 			{
 				Name:        "name",
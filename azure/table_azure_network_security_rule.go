@@ -0,0 +1,289 @@
+package azure
+
+import (
+	"context"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/profiles/latest/network/mgmt/network"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+)
+
+//// TABLE DEFINITION
+
+func tableAzureNetworkSecurityRule(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "azure_network_security_rule",
+		Description: "Azure Network Security Rule",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.AllColumns([]string{"nsg_name", "name", "resource_group"}),
+			Hydrate:    getNetworkSecurityRule,
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: isNotFoundError([]string{"ResourceNotFound", "ResourceGroupNotFound", "404"}),
+			},
+		},
+		List: &plugin.ListConfig{
+			Hydrate:       listNetworkSecurityRules,
+			ParentHydrate: listNetworkSecurityGroups,
+		},
+		Columns: azureColumns([]*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name of the resource that is unique within a resource group.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "id",
+				Description: "The resource ID.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromGo(),
+			},
+			{
+				Name:        "nsg_name",
+				Description: "The name of the network security group the rule belongs to.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("NSGName"),
+			},
+			{
+				Name:        "type",
+				Description: "The type of the resource.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "etag",
+				Description: "A unique read-only string that changes whenever the resource is updated.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "description",
+				Description: "A description for this rule.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("SecurityRulePropertiesFormat.Description"),
+			},
+			{
+				Name:        "protocol",
+				Description: "Network protocol this rule applies to. Possible values include: 'Tcp', 'Udp', 'Icmp', 'Esp', '*', 'Ah'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("SecurityRulePropertiesFormat.Protocol"),
+			},
+			{
+				Name:        "source_port_range",
+				Description: "The source port or range. Integer or range between 0 and 65535. Asterisk '*' can also be used to match all ports.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("SecurityRulePropertiesFormat.SourcePortRange"),
+			},
+			{
+				Name:        "source_port_ranges",
+				Description: "The source port ranges.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("SecurityRulePropertiesFormat.SourcePortRanges"),
+			},
+			{
+				Name:        "destination_port_range",
+				Description: "The destination port or range. Integer or range between 0 and 65535. Asterisk '*' can also be used to match all ports.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("SecurityRulePropertiesFormat.DestinationPortRange"),
+			},
+			{
+				Name:        "destination_port_ranges",
+				Description: "The destination port ranges.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("SecurityRulePropertiesFormat.DestinationPortRanges"),
+			},
+			{
+				Name:        "source_address_prefix",
+				Description: "The CIDR or source IP range. Asterisk '*' can also be used to match all source IPs. Default tags such as 'VirtualNetwork', 'AzureLoadBalancer' and 'Internet' can also be used.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("SecurityRulePropertiesFormat.SourceAddressPrefix"),
+			},
+			{
+				Name:        "source_address_prefixes",
+				Description: "The CIDR or source IP ranges.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("SecurityRulePropertiesFormat.SourceAddressPrefixes"),
+			},
+			{
+				Name:        "source_application_security_groups",
+				Description: "The application security groups specified as source.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("SecurityRulePropertiesFormat.SourceApplicationSecurityGroups"),
+			},
+			{
+				Name:        "destination_address_prefix",
+				Description: "The destination address prefix. CIDR or destination IP range. Asterisk '*' can also be used to match all destination IPs.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("SecurityRulePropertiesFormat.DestinationAddressPrefix"),
+			},
+			{
+				Name:        "destination_address_prefixes",
+				Description: "The destination address prefixes. CIDR or destination IP ranges.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("SecurityRulePropertiesFormat.DestinationAddressPrefixes"),
+			},
+			{
+				Name:        "destination_application_security_groups",
+				Description: "The application security groups specified as destination.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("SecurityRulePropertiesFormat.DestinationApplicationSecurityGroups"),
+			},
+			{
+				Name:        "access",
+				Description: "Specifies whether network traffic is allowed or denied. Possible values include: 'Allow', 'Deny'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("SecurityRulePropertiesFormat.Access"),
+			},
+			{
+				Name:        "direction",
+				Description: "The direction of the rule. Possible values include: 'Inbound', 'Outbound'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("SecurityRulePropertiesFormat.Direction"),
+			},
+			{
+				Name:        "priority",
+				Description: "The priority of the rule. The value can be between 100 and 4096. The lower the priority number, the higher the priority of the rule.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("SecurityRulePropertiesFormat.Priority"),
+			},
+			{
+				Name:        "provisioning_state",
+				Description: "The provisioning state of the security rule resource.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("SecurityRulePropertiesFormat.ProvisioningState"),
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+			{
+				Name:        "akas",
+				Description: ColumnDescriptionAkas,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("ID").Transform(idToAkas),
+			},
+
+			// Azure standard columns
+			{
+				Name:        "region",
+				Description: ColumnDescriptionRegion,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Location").Transform(toLower),
+			},
+			{
+				Name:        "resource_group",
+				Description: ColumnDescriptionResourceGroup,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ID").Transform(extractResourceGroupFromID),
+			},
+		}),
+	}
+}
+
+type NetworkSecurityRuleInfo = struct {
+	network.SecurityRule
+	NSGName  *string
+	Location *string
+}
+
+//// LIST FUNCTION
+
+func listNetworkSecurityRules(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	// Get the details of the network security group
+	nsg := h.Item.(network.SecurityGroup)
+	resourceGroup := strings.Split(*nsg.ID, "/")[4]
+
+	session, err := GetNewSession(ctx, d, "MANAGEMENT")
+	if err != nil {
+		return nil, err
+	}
+	subscriptionID := session.SubscriptionID
+
+	client := network.NewSecurityRulesClientWithBaseURI(session.ResourceManagerEndpoint, subscriptionID)
+	client.Authorizer = session.Authorizer
+
+	result, err := client.List(ctx, resourceGroup, *nsg.Name)
+	if err != nil {
+		plugin.Logger(ctx).Error("listNetworkSecurityRules", "list", err)
+		return nil, err
+	}
+
+	for _, rule := range result.Values() {
+		d.StreamListItem(ctx, NetworkSecurityRuleInfo{rule, nsg.Name, nsg.Location})
+		// Check if context has been cancelled or if the limit has been hit (if specified)
+		// if there is a limit, it will return the number of rows required to reach this limit
+		if d.RowsRemaining(ctx) == 0 {
+			return nil, nil
+		}
+	}
+
+	for result.NotDone() {
+		err = result.NextWithContext(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("listNetworkSecurityRules", "list_paging", err)
+			return nil, err
+		}
+		for _, rule := range result.Values() {
+			d.StreamListItem(ctx, NetworkSecurityRuleInfo{rule, nsg.Name, nsg.Location})
+			// Check if context has been cancelled or if the limit has been hit (if specified)
+			// if there is a limit, it will return the number of rows required to reach this limit
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, err
+}
+
+//// HYDRATE FUNCTIONS
+
+func getNetworkSecurityRule(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("getNetworkSecurityRule")
+
+	nsgName := d.EqualsQuals["nsg_name"].GetStringValue()
+	name := d.EqualsQuals["name"].GetStringValue()
+	resourceGroup := d.EqualsQuals["resource_group"].GetStringValue()
+
+	// Handle empty check
+	if nsgName == "" || name == "" || resourceGroup == "" {
+		return nil, nil
+	}
+
+	session, err := GetNewSession(ctx, d, "MANAGEMENT")
+	if err != nil {
+		return nil, err
+	}
+	subscriptionID := session.SubscriptionID
+
+	client := network.NewSecurityRulesClientWithBaseURI(session.ResourceManagerEndpoint, subscriptionID)
+	client.Authorizer = session.Authorizer
+
+	op, err := client.Get(ctx, resourceGroup, nsgName, name)
+	if err != nil {
+		plugin.Logger(ctx).Error("getNetworkSecurityRule", "get", err)
+		return nil, err
+	}
+
+	// In some cases resource does not give any notFound error
+	// instead of notFound error, it returns empty data
+	if op.ID == nil {
+		return nil, nil
+	}
+
+	// The security rule does not return the location, so it is taken from the network security group
+	nsgClient := network.NewSecurityGroupsClientWithBaseURI(session.ResourceManagerEndpoint, subscriptionID)
+	nsgClient.Authorizer = session.Authorizer
+
+	nsg, err := nsgClient.Get(ctx, resourceGroup, nsgName, "")
+	if err != nil {
+		plugin.Logger(ctx).Error("getNetworkSecurityRule", "get_network_security_group", err)
+		return nil, err
+	}
+
+	return NetworkSecurityRuleInfo{op, nsg.Name, nsg.Location}, nil
+}
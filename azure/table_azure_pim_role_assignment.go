@@ -0,0 +1,273 @@
+package azure
+
+import (
+	"context"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/authorization/armauthorization/v2"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+)
+
+//// TABLE DEFINITION
+
+func tableAzurePimRoleAssignment(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "azure_pim_role_assignment",
+		Description: "Azure Privileged Identity Management Role Assignment",
+		List: &plugin.ListConfig{
+			Hydrate: listPimRoleAssignments,
+		},
+		Columns: azureColumns([]*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name of the role assignment or role eligibility schedule.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "id",
+				Description: "The ID of the role assignment or role eligibility schedule.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromGo(),
+			},
+			{
+				Name:        "type",
+				Description: "The type of the schedule, e.g. 'Microsoft.Authorization/roleEligibilitySchedules'.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "assignment_type",
+				Description: "The type of the assignment. 'Eligible' for role eligibility schedules, 'Assigned' for permanent or time-bound assignments, and 'Activated' for just-in-time activations of an eligible role.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "scope",
+				Description: "The scope of the assignment.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "role_definition_id",
+				Description: "The ID of the role definition.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("RoleDefinitionID"),
+			},
+			{
+				Name:        "principal_id",
+				Description: "The ID of the principal the role is assigned to.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("PrincipalID"),
+			},
+			{
+				Name:        "principal_type",
+				Description: "The type of the principal. Possible values include: 'User', 'Group', 'ServicePrincipal', 'ForeignGroup', 'Device'.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "membership_type",
+				Description: "How the principal holds the assignment. Possible values include: 'Direct', 'Group', 'Inherited'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("MemberType"),
+			},
+			{
+				Name:        "status",
+				Description: "The status of the schedule, e.g. 'Provisioned' or 'Accepted'.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "start_date_time",
+				Description: "The time the assignment starts.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "end_date_time",
+				Description: "The time the assignment expires. Empty for assignments that never expire.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "condition",
+				Description: "The conditions on the assignment.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "condition_version",
+				Description: "The version of the condition.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "linked_role_eligibility_schedule_id",
+				Description: "The ID of the role eligibility schedule an activated assignment was created from.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("LinkedRoleEligibilityScheduleID"),
+			},
+			{
+				Name:        "created_on",
+				Description: "The time the schedule was created.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "updated_on",
+				Description: "The time the schedule was last updated.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "expanded_properties",
+				Description: "Additional details of the principal, role definition and scope of the assignment.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+			{
+				Name:        "akas",
+				Description: ColumnDescriptionAkas,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("ID").Transform(idToAkas),
+			},
+		}),
+	}
+}
+
+// PimRoleAssignmentInfo holds the common fields of role assignment schedules
+// and role eligibility schedules, so both can be returned by the same table
+type PimRoleAssignmentInfo struct {
+	ID                              *string
+	Name                            *string
+	Type                            *string
+	AssignmentType                  *string
+	Scope                           *string
+	RoleDefinitionID                *string
+	PrincipalID                     *string
+	PrincipalType                   *armauthorization.PrincipalType
+	MemberType                      *armauthorization.MemberType
+	Status                          *armauthorization.Status
+	StartDateTime                   *time.Time
+	EndDateTime                     *time.Time
+	Condition                       *string
+	ConditionVersion                *string
+	LinkedRoleEligibilityScheduleID *string
+	CreatedOn                       *time.Time
+	UpdatedOn                       *time.Time
+	ExpandedProperties              *armauthorization.ExpandedProperties
+}
+
+//// LIST FUNCTION
+
+func listPimRoleAssignments(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	session, err := GetNewSessionUpdated(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_pim_role_assignment.listPimRoleAssignments", "session_error", err)
+		return nil, err
+	}
+	scope := "/subscriptions/" + session.SubscriptionID
+
+	eligibilityClient, err := armauthorization.NewRoleEligibilitySchedulesClient(session.Cred, session.ClientOptions)
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_pim_role_assignment.listPimRoleAssignments", "client_error", err)
+		return nil, err
+	}
+
+	eligibilityPager := eligibilityClient.NewListForScopePager(scope, nil)
+	for eligibilityPager.More() {
+		res, err := eligibilityPager.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("azure_pim_role_assignment.listPimRoleAssignments", "eligibility_api_error", err)
+			return nil, err
+		}
+		for _, schedule := range res.Value {
+			d.StreamListItem(ctx, pimRoleEligibilityScheduleInfo(schedule))
+			// Check if context has been cancelled or if the limit has been hit (if specified)
+			// if there is a limit, it will return the number of rows required to reach this limit
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	assignmentClient, err := armauthorization.NewRoleAssignmentSchedulesClient(session.Cred, session.ClientOptions)
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_pim_role_assignment.listPimRoleAssignments", "client_error", err)
+		return nil, err
+	}
+
+	assignmentPager := assignmentClient.NewListForScopePager(scope, nil)
+	for assignmentPager.More() {
+		res, err := assignmentPager.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("azure_pim_role_assignment.listPimRoleAssignments", "assignment_api_error", err)
+			return nil, err
+		}
+		for _, schedule := range res.Value {
+			d.StreamListItem(ctx, pimRoleAssignmentScheduleInfo(schedule))
+			// Check if context has been cancelled or if the limit has been hit (if specified)
+			// if there is a limit, it will return the number of rows required to reach this limit
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+//// UTILITY FUNCTIONS
+
+func pimRoleEligibilityScheduleInfo(schedule *armauthorization.RoleEligibilitySchedule) PimRoleAssignmentInfo {
+	assignmentType := "Eligible"
+	info := PimRoleAssignmentInfo{
+		ID:             schedule.ID,
+		Name:           schedule.Name,
+		Type:           schedule.Type,
+		AssignmentType: &assignmentType,
+	}
+	if p := schedule.Properties; p != nil {
+		info.Scope = p.Scope
+		info.RoleDefinitionID = p.RoleDefinitionID
+		info.PrincipalID = p.PrincipalID
+		info.PrincipalType = p.PrincipalType
+		info.MemberType = p.MemberType
+		info.Status = p.Status
+		info.StartDateTime = p.StartDateTime
+		info.EndDateTime = p.EndDateTime
+		info.Condition = p.Condition
+		info.ConditionVersion = p.ConditionVersion
+		info.CreatedOn = p.CreatedOn
+		info.UpdatedOn = p.UpdatedOn
+		info.ExpandedProperties = p.ExpandedProperties
+	}
+	return info
+}
+
+func pimRoleAssignmentScheduleInfo(schedule *armauthorization.RoleAssignmentSchedule) PimRoleAssignmentInfo {
+	info := PimRoleAssignmentInfo{
+		ID:   schedule.ID,
+		Name: schedule.Name,
+		Type: schedule.Type,
+	}
+	if p := schedule.Properties; p != nil {
+		if p.AssignmentType != nil {
+			assignmentType := string(*p.AssignmentType)
+			info.AssignmentType = &assignmentType
+		}
+		info.Scope = p.Scope
+		info.RoleDefinitionID = p.RoleDefinitionID
+		info.PrincipalID = p.PrincipalID
+		info.PrincipalType = p.PrincipalType
+		info.MemberType = p.MemberType
+		info.Status = p.Status
+		info.StartDateTime = p.StartDateTime
+		info.EndDateTime = p.EndDateTime
+		info.Condition = p.Condition
+		info.ConditionVersion = p.ConditionVersion
+		info.LinkedRoleEligibilityScheduleID = p.LinkedRoleEligibilityScheduleID
+		info.CreatedOn = p.CreatedOn
+		info.UpdatedOn = p.UpdatedOn
+		info.ExpandedProperties = p.ExpandedProperties
+	}
+	return info
+}
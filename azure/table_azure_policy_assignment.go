@@ -2,6 +2,7 @@ package azure
 
 import (
 	"context"
+	"strings"
 
 	"github.com/Azure/azure-sdk-for-go/profiles/latest/resources/mgmt/policy"
 	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
@@ -22,6 +23,12 @@ func tableAzurePolicyAssignment(_ context.Context) *plugin.Table {
 		},
 		List: &plugin.ListConfig{
 			Hydrate: listPolicyAssignments,
+			KeyColumns: plugin.KeyColumnSlice{
+				{
+					Name:    "scope",
+					Require: plugin.Optional,
+				},
+			},
 		},
 		Columns: azureColumns([]*plugin.Column{
 			{
@@ -135,9 +142,10 @@ func listPolicyAssignments(ctx context.Context, d *plugin.QueryData, _ *plugin.H
 	PolicyClient := policy.NewAssignmentsClientWithBaseURI(session.ResourceManagerEndpoint, subscriptionID)
 	PolicyClient.Authorizer = session.Authorizer
 
-	result, err := PolicyClient.List(ctx, "")
+	result, err := listPolicyAssignmentsForScope(ctx, PolicyClient, d.EqualsQualString("scope"))
 	if err != nil {
-		return err, nil
+		plugin.Logger(ctx).Error("listPolicyAssignments", "list", err)
+		return nil, err
 	}
 
 	for _, policy := range result.Values() {
@@ -168,6 +176,32 @@ func listPolicyAssignments(ctx context.Context, d *plugin.QueryData, _ *plugin.H
 	return nil, nil
 }
 
+// listPolicyAssignmentsForScope returns the first page of the policy assignments
+// applicable at the given scope. The listing APIs also return the assignments
+// inherited from the parent scopes, which are filtered out by the scope qual.
+func listPolicyAssignmentsForScope(ctx context.Context, client policy.AssignmentsClient, scope string) (policy.AssignmentListResultPage, error) {
+	parts := strings.Split(strings.Trim(scope, "/"), "/")
+
+	switch {
+	// /providers/Microsoft.Management/managementGroups/{managementGroupId}
+	case len(parts) == 4 && strings.EqualFold(parts[0], "providers") && strings.EqualFold(parts[2], "managementGroups"):
+		// A filter is required when listing policy assignments at management group scope
+		return client.ListForManagementGroup(ctx, parts[3], "atScope()")
+
+	// /subscriptions/{subscriptionId}/resourceGroups/{resourceGroupName}/providers/{namespace}/[{parentResourcePath}/]{resourceType}/{resourceName}
+	case len(parts) >= 8 && len(parts)%2 == 0 && strings.EqualFold(parts[2], "resourceGroups") && strings.EqualFold(parts[4], "providers"):
+		resourceType, resourceName := parts[len(parts)-2], parts[len(parts)-1]
+		parentResourcePath := strings.Join(parts[6:len(parts)-2], "/")
+		return client.ListForResource(ctx, parts[3], parts[5], parentResourcePath, resourceType, resourceName, "")
+
+	// /subscriptions/{subscriptionId}/resourceGroups/{resourceGroupName}
+	case len(parts) == 4 && strings.EqualFold(parts[2], "resourceGroups"):
+		return client.ListForResourceGroup(ctx, parts[3], "")
+	}
+
+	return client.List(ctx, "")
+}
+
 //// HYDRATE FUNCTIONS
 
 func getPolicyAssignment(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
@@ -0,0 +1,252 @@
+package azure
+
+import (
+	"context"
+
+	"github.com/Azure/azure-sdk-for-go/profiles/latest/postgresql/mgmt/postgresqlflexibleservers"
+	"github.com/Azure/azure-sdk-for-go/profiles/latest/resources/mgmt/resources"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+)
+
+//// TABLE DEFINITION
+
+func tableAzurePostgreSqlFlexibleServerFirewallRule(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "azure_postgresql_flexible_server_firewall_rule",
+		Description: "Azure PostgreSQL Flexible Server Firewall Rule",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.AllColumns([]string{"server_name", "name", "resource_group"}),
+			Hydrate:    getPostgreSqlFlexibleServerFirewallRule,
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: isNotFoundError([]string{"ResourceNotFound", "ResourceGroupNotFound", "404"}),
+			},
+		},
+		List: &plugin.ListConfig{
+			// The flexible servers are listed per resource group, so the rules are too
+			ParentHydrate: listResourceGroups,
+			Hydrate:       listPostgreSqlFlexibleServerFirewallRules,
+		},
+		Columns: azureColumns([]*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name of the firewall rule.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "id",
+				Description: "The ID of the firewall rule.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromGo(),
+			},
+			{
+				Name:        "server_name",
+				Description: "The name of the server the firewall rule belongs to.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "type",
+				Description: "The type of the resource.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "start_ip_address",
+				Description: "The start IP address of the firewall rule.",
+				Type:        proto.ColumnType_IPADDR,
+				Transform:   transform.FromField("FirewallRuleProperties.StartIPAddress"),
+			},
+			{
+				Name:        "end_ip_address",
+				Description: "The end IP address of the firewall rule.",
+				Type:        proto.ColumnType_IPADDR,
+				Transform:   transform.FromField("FirewallRuleProperties.EndIPAddress"),
+			},
+			{
+				Name:        "is_allow_all_azure_services",
+				Description: "True if the rule allows access from all Azure services, which is the case when the start and end IP addresses are both '0.0.0.0'.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.From(isPostgreSqlFlexibleServerFirewallRuleAllowAllAzureServices),
+			},
+			{
+				Name:        "is_allow_all_ips",
+				Description: "True if the rule allows access from any IP address, which is the case when the start IP address is '0.0.0.0' and the end IP address is '255.255.255.255'.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.From(isPostgreSqlFlexibleServerFirewallRuleAllowAllIPs),
+			},
+			{
+				Name:        "system_data",
+				Description: "The metadata relating to the creation and last modification of the firewall rule.",
+				Type:        proto.ColumnType_JSON,
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+			{
+				Name:        "akas",
+				Description: ColumnDescriptionAkas,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("ID").Transform(idToAkas),
+			},
+
+			// Azure standard columns
+			{
+				Name:        "region",
+				Description: ColumnDescriptionRegion,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Location").Transform(toLower),
+			},
+			{
+				Name:        "resource_group",
+				Description: ColumnDescriptionResourceGroup,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ID").Transform(extractResourceGroupFromID),
+			},
+		}),
+	}
+}
+
+type PostgreSqlFlexibleServerFirewallRuleInfo = struct {
+	postgresqlflexibleservers.FirewallRule
+	ServerName *string
+	Location   *string
+}
+
+//// LIST FUNCTION
+
+func listPostgreSqlFlexibleServerFirewallRules(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	resourceGroupName := h.Item.(resources.Group).Name
+
+	session, err := GetNewSession(ctx, d, "MANAGEMENT")
+	if err != nil {
+		return nil, err
+	}
+	subscriptionID := session.SubscriptionID
+
+	serverClient := postgresqlflexibleservers.NewServersClientWithBaseURI(session.ResourceManagerEndpoint, subscriptionID)
+	serverClient.Authorizer = session.Authorizer
+
+	client := postgresqlflexibleservers.NewFirewallRulesClientWithBaseURI(session.ResourceManagerEndpoint, subscriptionID)
+	client.Authorizer = session.Authorizer
+
+	servers, err := serverClient.ListByResourceGroupComplete(ctx, *resourceGroupName)
+	if err != nil {
+		plugin.Logger(ctx).Error("listPostgreSqlFlexibleServerFirewallRules", "list_servers", err)
+		return nil, err
+	}
+
+	for servers.NotDone() {
+		server := servers.Value()
+
+		result, err := client.ListByServer(ctx, *resourceGroupName, *server.Name)
+		if err != nil {
+			plugin.Logger(ctx).Error("listPostgreSqlFlexibleServerFirewallRules", "list", err)
+			return nil, err
+		}
+
+		for _, rule := range result.Values() {
+			d.StreamListItem(ctx, PostgreSqlFlexibleServerFirewallRuleInfo{rule, server.Name, server.Location})
+			// Check if context has been cancelled or if the limit has been hit (if specified)
+			// if there is a limit, it will return the number of rows required to reach this limit
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+
+		for result.NotDone() {
+			err = result.NextWithContext(ctx)
+			if err != nil {
+				plugin.Logger(ctx).Error("listPostgreSqlFlexibleServerFirewallRules", "list_paging", err)
+				return nil, err
+			}
+			for _, rule := range result.Values() {
+				d.StreamListItem(ctx, PostgreSqlFlexibleServerFirewallRuleInfo{rule, server.Name, server.Location})
+				// Check if context has been cancelled or if the limit has been hit (if specified)
+				// if there is a limit, it will return the number of rows required to reach this limit
+				if d.RowsRemaining(ctx) == 0 {
+					return nil, nil
+				}
+			}
+		}
+
+		err = servers.NextWithContext(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("listPostgreSqlFlexibleServerFirewallRules", "list_servers_paging", err)
+			return nil, err
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getPostgreSqlFlexibleServerFirewallRule(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("getPostgreSqlFlexibleServerFirewallRule")
+
+	serverName := d.EqualsQualString("server_name")
+	name := d.EqualsQualString("name")
+	resourceGroup := d.EqualsQualString("resource_group")
+
+	// Handle empty check
+	if serverName == "" || name == "" || resourceGroup == "" {
+		return nil, nil
+	}
+
+	session, err := GetNewSession(ctx, d, "MANAGEMENT")
+	if err != nil {
+		return nil, err
+	}
+	subscriptionID := session.SubscriptionID
+
+	client := postgresqlflexibleservers.NewFirewallRulesClientWithBaseURI(session.ResourceManagerEndpoint, subscriptionID)
+	client.Authorizer = session.Authorizer
+
+	op, err := client.Get(ctx, resourceGroup, serverName, name)
+	if err != nil {
+		plugin.Logger(ctx).Error("getPostgreSqlFlexibleServerFirewallRule", "get", err)
+		return nil, err
+	}
+
+	// In some cases resource does not give any notFound error
+	// instead of notFound error, it returns empty data
+	if op.ID == nil {
+		return nil, nil
+	}
+
+	// The firewall rule does not return the location, so it is taken from the server
+	serverClient := postgresqlflexibleservers.NewServersClientWithBaseURI(session.ResourceManagerEndpoint, subscriptionID)
+	serverClient.Authorizer = session.Authorizer
+
+	server, err := serverClient.Get(ctx, resourceGroup, serverName)
+	if err != nil {
+		plugin.Logger(ctx).Error("getPostgreSqlFlexibleServerFirewallRule", "get_server", err)
+		return nil, err
+	}
+
+	return PostgreSqlFlexibleServerFirewallRuleInfo{op, server.Name, server.Location}, nil
+}
+
+//// TRANSFORM FUNCTIONS
+
+func isPostgreSqlFlexibleServerFirewallRuleAllowAllAzureServices(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	rule := d.HydrateItem.(PostgreSqlFlexibleServerFirewallRuleInfo)
+	if rule.FirewallRuleProperties == nil || rule.StartIPAddress == nil || rule.EndIPAddress == nil {
+		return false, nil
+	}
+	return *rule.StartIPAddress == "0.0.0.0" && *rule.EndIPAddress == "0.0.0.0", nil
+}
+
+func isPostgreSqlFlexibleServerFirewallRuleAllowAllIPs(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	rule := d.HydrateItem.(PostgreSqlFlexibleServerFirewallRuleInfo)
+	if rule.FirewallRuleProperties == nil || rule.StartIPAddress == nil || rule.EndIPAddress == nil {
+		return false, nil
+	}
+	return *rule.StartIPAddress == "0.0.0.0" && *rule.EndIPAddress == "255.255.255.255", nil
+}
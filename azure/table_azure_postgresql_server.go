@@ -518,6 +518,10 @@ func getServerSecurityAlertPolicy(ctx context.Context, d *plugin.QueryData, h *p
 		return nil, err
 	}
 
+	if op.SecurityAlertPolicyProperties == nil {
+		return nil, nil
+	}
+
 	return *op.SecurityAlertPolicyProperties, nil
 }
 
@@ -0,0 +1,193 @@
+package azure
+
+import (
+	"context"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/profiles/latest/postgresql/mgmt/postgresql"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+)
+
+//// TABLE DEFINITION
+
+func tableAzurePostgreSQLServerConfiguration(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "azure_postgresql_server_configuration",
+		Description: "Azure PostgreSQL Server Configuration",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.AllColumns([]string{"server_name", "name", "resource_group"}),
+			Hydrate:    getPostgreSQLServerConfiguration,
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: isNotFoundError([]string{"ResourceNotFound", "ResourceGroupNotFound", "NotFound", "404"}),
+			},
+		},
+		List: &plugin.ListConfig{
+			Hydrate:       listPostgreSQLServerConfigurations,
+			ParentHydrate: listPostgreSqlServers,
+		},
+		Columns: azureColumns([]*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name of the configuration, e.g. 'log_checkpoints'.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "id",
+				Description: "The resource ID of the configuration.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromGo(),
+			},
+			{
+				Name:        "server_name",
+				Description: "The name of the server the configuration belongs to.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "type",
+				Description: "The type of the resource.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "value",
+				Description: "The value of the configuration.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ConfigurationProperties.Value"),
+			},
+			{
+				Name:        "default_value",
+				Description: "The default value of the configuration.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ConfigurationProperties.DefaultValue"),
+			},
+			{
+				Name:        "data_type",
+				Description: "The data type of the configuration, e.g. 'Integer', 'Boolean' or 'Enumeration'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ConfigurationProperties.DataType"),
+			},
+			{
+				Name:        "allowed_values",
+				Description: "The allowed values of the configuration.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ConfigurationProperties.AllowedValues"),
+			},
+			{
+				Name:        "source",
+				Description: "The source of the configuration, e.g. 'system-default' or 'user-override'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ConfigurationProperties.Source"),
+			},
+			{
+				Name:        "description",
+				Description: "The description of the configuration.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ConfigurationProperties.Description"),
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+			{
+				Name:        "akas",
+				Description: ColumnDescriptionAkas,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("ID").Transform(idToAkas),
+			},
+
+			// Azure standard columns
+			{
+				Name:        "resource_group",
+				Description: ColumnDescriptionResourceGroup,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ID").Transform(extractResourceGroupFromID),
+			},
+		}),
+	}
+}
+
+type PostgreSQLServerConfigurationInfo = struct {
+	postgresql.Configuration
+	ServerName *string
+}
+
+//// LIST FUNCTION
+
+func listPostgreSQLServerConfigurations(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	server := h.Item.(postgresql.Server)
+	resourceGroup := strings.Split(*server.ID, "/")[4]
+
+	session, err := GetNewSession(ctx, d, "MANAGEMENT")
+	if err != nil {
+		return nil, err
+	}
+	subscriptionID := session.SubscriptionID
+
+	client := postgresql.NewConfigurationsClientWithBaseURI(session.ResourceManagerEndpoint, subscriptionID)
+	client.Authorizer = session.Authorizer
+
+	result, err := client.ListByServer(ctx, resourceGroup, *server.Name)
+	if err != nil {
+		plugin.Logger(ctx).Error("listPostgreSQLServerConfigurations", "list", err)
+		return nil, err
+	}
+
+	if result.Value == nil {
+		return nil, nil
+	}
+
+	for _, configuration := range *result.Value {
+		d.StreamListItem(ctx, PostgreSQLServerConfigurationInfo{configuration, server.Name})
+		// Check if context has been cancelled or if the limit has been hit (if specified)
+		// if there is a limit, it will return the number of rows required to reach this limit
+		if d.RowsRemaining(ctx) == 0 {
+			return nil, nil
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getPostgreSQLServerConfiguration(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("getPostgreSQLServerConfiguration")
+
+	serverName := d.EqualsQuals["server_name"].GetStringValue()
+	name := d.EqualsQuals["name"].GetStringValue()
+	resourceGroup := d.EqualsQuals["resource_group"].GetStringValue()
+
+	// Handle empty check
+	if serverName == "" || name == "" || resourceGroup == "" {
+		return nil, nil
+	}
+
+	session, err := GetNewSession(ctx, d, "MANAGEMENT")
+	if err != nil {
+		return nil, err
+	}
+	subscriptionID := session.SubscriptionID
+
+	client := postgresql.NewConfigurationsClientWithBaseURI(session.ResourceManagerEndpoint, subscriptionID)
+	client.Authorizer = session.Authorizer
+
+	op, err := client.Get(ctx, resourceGroup, serverName, name)
+	if err != nil {
+		plugin.Logger(ctx).Error("getPostgreSQLServerConfiguration", "get", err)
+		return nil, err
+	}
+
+	// In some cases resource does not give any notFound error
+	// instead of notFound error, it returns empty data
+	if op.ID != nil {
+		return PostgreSQLServerConfigurationInfo{op, &serverName}, nil
+	}
+
+	return nil, nil
+}
@@ -0,0 +1,290 @@
+package azure
+
+import (
+	"context"
+
+	"github.com/Azure/azure-sdk-for-go/profiles/latest/resources/mgmt/resources"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+)
+
+// The Azure SDK version used by the plugin does not provide a client for
+// Azure Monitor workspaces, so they are read as generic resources
+const (
+	prometheusWorkspaceAPIVersion    = "2023-04-03"
+	dataCollectionEndpointAPIVersion = "2022-06-01"
+)
+
+//// TABLE DEFINITION
+
+func tableAzurePrometheusWorkspace(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "azure_prometheus_workspace",
+		Description: "Azure Monitor Managed Prometheus Workspace",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.AllColumns([]string{"name", "resource_group"}),
+			Hydrate:    getPrometheusWorkspace,
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: isNotFoundError([]string{"ResourceNotFound", "ResourceGroupNotFound", "404"}),
+			},
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listPrometheusWorkspaces,
+		},
+		HydrateConfig: []plugin.HydrateConfig{
+			{
+				Func: getPrometheusWorkspaceDataCollectionEndpoint,
+				IgnoreConfig: &plugin.IgnoreConfig{
+					ShouldIgnoreErrorFunc: isNotFoundError([]string{"ResourceNotFound", "404"}),
+				},
+			},
+		},
+		Columns: azureColumns([]*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name of the workspace.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "id",
+				Description: "The ID of the workspace.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromGo(),
+			},
+			{
+				Name:        "type",
+				Description: "The type of the resource.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "provisioning_state",
+				Description: "The provisioning state of the workspace. Possible values include: 'Creating', 'Succeeded', 'Deleting', 'Failed', 'Canceled'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties").TransformP(extractGenericResourceProperty, "provisioningState"),
+			},
+			{
+				Name:        "account_id",
+				Description: "The immutable ID of the workspace.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties").TransformP(extractGenericResourceProperty, "accountId"),
+			},
+			{
+				Name:        "prometheus_query_endpoint",
+				Description: "The Prometheus query endpoint of the workspace.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties").TransformP(extractGenericResourceProperty, "metrics.prometheusQueryEndpoint"),
+			},
+			{
+				Name:        "metrics_internal_id",
+				Description: "An internal identifier for the metrics container of the workspace.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties").TransformP(extractGenericResourceProperty, "metrics.internalId"),
+			},
+			{
+				Name:        "default_data_collection_endpoint_id",
+				Description: "The ID of the default data collection endpoint, through which Prometheus metrics are ingested into the workspace.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties").TransformP(extractGenericResourceProperty, "defaultIngestionSettings.dataCollectionEndpointResourceId"),
+			},
+			{
+				Name:        "default_data_collection_rule_id",
+				Description: "The ID of the default data collection rule of the workspace.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties").TransformP(extractGenericResourceProperty, "defaultIngestionSettings.dataCollectionRuleResourceId"),
+			},
+			{
+				Name:        "metrics_ingestion_endpoint",
+				Description: "The endpoint of the default data collection endpoint, through which Prometheus metrics are ingested into the workspace.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getPrometheusWorkspaceDataCollectionEndpoint,
+				Transform:   transform.FromField("Properties").TransformP(extractGenericResourceProperty, "metricsIngestion.endpoint"),
+			},
+			{
+				Name:        "ingestion_endpoint",
+				Description: "The logs ingestion endpoint of the default data collection endpoint of the workspace.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getPrometheusWorkspaceDataCollectionEndpoint,
+				Transform:   transform.FromField("Properties").TransformP(extractGenericResourceProperty, "logsIngestion.endpoint"),
+			},
+			{
+				Name:        "public_network_access",
+				Description: "Whether requests from the public network are allowed. Possible values include: 'Enabled', 'Disabled'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties").TransformP(extractGenericResourceProperty, "publicNetworkAccess"),
+			},
+			{
+				Name:        "metrics",
+				Description: "The information about the metrics container of the workspace.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Properties").TransformP(extractGenericResourceProperty, "metrics"),
+			},
+			{
+				Name:        "default_ingestion_settings",
+				Description: "The data collection rule and endpoint created by default when the workspace was created.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Properties").TransformP(extractGenericResourceProperty, "defaultIngestionSettings"),
+			},
+			{
+				Name:        "private_endpoint_connections",
+				Description: "The list of private endpoint connections of the workspace.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Properties").TransformP(extractGenericResourceProperty, "privateEndpointConnections"),
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+			{
+				Name:        "tags",
+				Description: ColumnDescriptionTags,
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "akas",
+				Description: ColumnDescriptionAkas,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("ID").Transform(idToAkas),
+			},
+
+			// Azure standard columns
+			{
+				Name:        "region",
+				Description: ColumnDescriptionRegion,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Location").Transform(toLower),
+			},
+			{
+				Name:        "resource_group",
+				Description: ColumnDescriptionResourceGroup,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ID").Transform(extractResourceGroupFromID),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listPrometheusWorkspaces(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	session, err := GetNewSession(ctx, d, "MANAGEMENT")
+	if err != nil {
+		return nil, err
+	}
+	subscriptionID := session.SubscriptionID
+
+	client := resources.NewClientWithBaseURI(session.ResourceManagerEndpoint, subscriptionID)
+	client.Authorizer = session.Authorizer
+
+	// The resource list does not return the properties of the resources, so
+	// each workspace is fetched by its ID
+	result, err := client.List(ctx, "resourceType eq 'Microsoft.Monitor/accounts'", "", nil)
+	if err != nil {
+		plugin.Logger(ctx).Error("listPrometheusWorkspaces", "list", err)
+		return nil, err
+	}
+
+	for {
+		for _, resource := range result.Values() {
+			workspace, err := client.GetByID(ctx, *resource.ID, prometheusWorkspaceAPIVersion)
+			if err != nil {
+				plugin.Logger(ctx).Error("listPrometheusWorkspaces", "get", err)
+				return nil, err
+			}
+			d.StreamListItem(ctx, workspace)
+			// Check if context has been cancelled or if the limit has been hit (if specified)
+			// if there is a limit, it will return the number of rows required to reach this limit
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+
+		if !result.NotDone() {
+			break
+		}
+		err = result.NextWithContext(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("listPrometheusWorkspaces", "list_paging", err)
+			return nil, err
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getPrometheusWorkspace(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("getPrometheusWorkspace")
+
+	name := d.EqualsQuals["name"].GetStringValue()
+	resourceGroup := d.EqualsQuals["resource_group"].GetStringValue()
+
+	// Handle empty name or resourceGroup
+	if name == "" || resourceGroup == "" {
+		return nil, nil
+	}
+
+	session, err := GetNewSession(ctx, d, "MANAGEMENT")
+	if err != nil {
+		return nil, err
+	}
+	subscriptionID := session.SubscriptionID
+
+	client := resources.NewClientWithBaseURI(session.ResourceManagerEndpoint, subscriptionID)
+	client.Authorizer = session.Authorizer
+
+	op, err := client.Get(ctx, resourceGroup, "Microsoft.Monitor", "", "accounts", name, prometheusWorkspaceAPIVersion)
+	if err != nil {
+		plugin.Logger(ctx).Error("getPrometheusWorkspace", "get", err)
+		return nil, err
+	}
+
+	// In some cases resource does not give any notFound error
+	// instead of notFound error, it returns empty data
+	if op.ID != nil {
+		return op, nil
+	}
+
+	return nil, nil
+}
+
+func getPrometheusWorkspaceDataCollectionEndpoint(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("getPrometheusWorkspaceDataCollectionEndpoint")
+
+	workspace := h.Item.(resources.GenericResource)
+	properties, ok := workspace.Properties.(map[string]interface{})
+	if !ok {
+		return nil, nil
+	}
+	ingestionSettings, ok := properties["defaultIngestionSettings"].(map[string]interface{})
+	if !ok {
+		return nil, nil
+	}
+	endpointID, ok := ingestionSettings["dataCollectionEndpointResourceId"].(string)
+	if !ok || endpointID == "" {
+		return nil, nil
+	}
+
+	session, err := GetNewSession(ctx, d, "MANAGEMENT")
+	if err != nil {
+		return nil, err
+	}
+	subscriptionID := session.SubscriptionID
+
+	client := resources.NewClientWithBaseURI(session.ResourceManagerEndpoint, subscriptionID)
+	client.Authorizer = session.Authorizer
+
+	op, err := client.GetByID(ctx, endpointID, dataCollectionEndpointAPIVersion)
+	if err != nil {
+		plugin.Logger(ctx).Error("getPrometheusWorkspaceDataCollectionEndpoint", "get", err)
+		return nil, err
+	}
+
+	return op, nil
+}
@@ -56,6 +56,12 @@ func tableAzurePublicIP(_ context.Context) *plugin.Table {
 				Type:        proto.ColumnType_STRING,
 				Transform:   transform.FromField("PublicIPAddressPropertiesFormat.ProvisioningState").Transform(transform.ToString),
 			},
+			{
+				Name:        "delete_option",
+				Description: "Specify what happens to the public IP address when the VM using it is deleted. Possible values include: 'Delete', 'Detach'",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("PublicIPAddressPropertiesFormat.DeleteOption").Transform(transform.ToString),
+			},
 			{
 				Name:        "ddos_custom_policy_id",
 				Description: "The DDoS custom policy associated with the public IP",
@@ -140,6 +146,18 @@ func tableAzurePublicIP(_ context.Context) *plugin.Table {
 				Type:        proto.ColumnType_STRING,
 				Transform:   transform.FromField("Sku.Name").Transform(transform.ToString),
 			},
+			{
+				Name:        "sku_tier",
+				Description: "Tier of a public IP address SKU. Possible values include: 'Regional', 'Global'",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Sku.Tier").Transform(transform.ToString),
+			},
+			{
+				Name:        "ddos_settings",
+				Description: "The DDoS protection settings associated with the public IP, including the protection mode and the DDoS protection plan",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("PublicIPAddressPropertiesFormat.DdosSettings"),
+			},
 			{
 				Name:        "ip_tags",
 				Description: "A list of tags associated with the public IP address",
@@ -27,6 +27,13 @@ func tableAzureRecoveryServicesVault(_ context.Context) *plugin.Table {
 		List: &plugin.ListConfig{
 			Hydrate: listRecoveryServicesVaults,
 		},
+		HydrateConfig: []plugin.HydrateConfig{
+			{
+				Func:           listRecoveryServicesVaultDiagnosticSettings,
+				MaxConcurrency: diagnosticSettingsMaxConcurrency,
+				Tags:           map[string]string{"service": "Microsoft.Insights", "action": "diagnosticSettings/list"},
+			},
+		},
 		Columns: azureColumns([]*plugin.Column{
 			{
 				Name:        "name",
@@ -0,0 +1,249 @@
+package azure
+
+import (
+	"context"
+
+	"github.com/Azure/azure-sdk-for-go/profiles/latest/redhatopenshift/mgmt/redhatopenshift"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+)
+
+//// TABLE DEFINITION
+
+func tableAzureRedHatOpenShiftCluster(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "azure_red_hat_openshift_cluster",
+		Description: "Azure Red Hat OpenShift Cluster",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.AllColumns([]string{"name", "resource_group"}),
+			Hydrate:    getRedHatOpenShiftCluster,
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: isNotFoundError([]string{"ResourceNotFound", "ResourceGroupNotFound", "404"}),
+			},
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listRedHatOpenShiftClusters,
+		},
+		Columns: azureColumns([]*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name of the cluster.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "id",
+				Description: "The ID of the cluster.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromGo(),
+			},
+			{
+				Name:        "type",
+				Description: "The type of the resource.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "provisioning_state",
+				Description: "The cluster provisioning state. Possible values include: 'AdminUpdating', 'Creating', 'Deleting', 'Failed', 'Succeeded', 'Updating'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("OpenShiftClusterProperties.ProvisioningState"),
+			},
+			{
+				Name:        "cluster_profile",
+				Description: "The cluster profile, with the domain, version, cluster resource group and FIPS validated modules. The pull secret is not returned.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("OpenShiftClusterProperties.ClusterProfile").Transform(maskRedHatOpenShiftClusterProfile),
+			},
+			{
+				Name:        "master_profile",
+				Description: "The cluster master profile, with the VM size and subnet ID of the master nodes.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("OpenShiftClusterProperties.MasterProfile"),
+			},
+			{
+				Name:        "worker_profiles",
+				Description: "The cluster worker profiles.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("OpenShiftClusterProperties.WorkerProfiles"),
+			},
+			{
+				Name:        "apiserver_profile",
+				Description: "The cluster API server profile, with its URL, IP address and visibility.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("OpenShiftClusterProperties.ApiserverProfile"),
+			},
+			{
+				Name:        "ingress_profiles",
+				Description: "The cluster ingress profiles.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("OpenShiftClusterProperties.IngressProfiles"),
+			},
+			{
+				Name:        "console_profile",
+				Description: "The console profile.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("OpenShiftClusterProperties.ConsoleProfile"),
+			},
+			{
+				Name:        "network_profile",
+				Description: "The cluster network profile, with the pod and service CIDRs.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("OpenShiftClusterProperties.NetworkProfile"),
+			},
+			{
+				Name:        "service_principal_profile",
+				Description: "The cluster service principal profile. The client secret is not returned.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("OpenShiftClusterProperties.ServicePrincipalProfile").Transform(maskRedHatOpenShiftServicePrincipalProfile),
+			},
+			{
+				Name:        "system_data",
+				Description: "Metadata pertaining to creation and last modification of the resource.",
+				Type:        proto.ColumnType_JSON,
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+			{
+				Name:        "tags",
+				Description: ColumnDescriptionTags,
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "akas",
+				Description: ColumnDescriptionAkas,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("ID").Transform(idToAkas),
+			},
+
+			// Azure standard columns
+			{
+				Name:        "region",
+				Description: ColumnDescriptionRegion,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Location").Transform(toLower),
+			},
+			{
+				Name:        "resource_group",
+				Description: ColumnDescriptionResourceGroup,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ID").Transform(extractResourceGroupFromID),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listRedHatOpenShiftClusters(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	session, err := GetNewSession(ctx, d, "MANAGEMENT")
+	if err != nil {
+		return nil, err
+	}
+	subscriptionID := session.SubscriptionID
+
+	client := redhatopenshift.NewOpenShiftClustersClientWithBaseURI(session.ResourceManagerEndpoint, subscriptionID)
+	client.Authorizer = session.Authorizer
+
+	result, err := client.List(ctx)
+	if err != nil {
+		plugin.Logger(ctx).Error("listRedHatOpenShiftClusters", "list", err)
+		return nil, err
+	}
+
+	for _, cluster := range result.Values() {
+		d.StreamListItem(ctx, cluster)
+		// Check if context has been cancelled or if the limit has been hit (if specified)
+		// if there is a limit, it will return the number of rows required to reach this limit
+		if d.RowsRemaining(ctx) == 0 {
+			return nil, nil
+		}
+	}
+
+	for result.NotDone() {
+		err = result.NextWithContext(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("listRedHatOpenShiftClusters", "list_paging", err)
+			return nil, err
+		}
+		for _, cluster := range result.Values() {
+			d.StreamListItem(ctx, cluster)
+			// Check if context has been cancelled or if the limit has been hit (if specified)
+			// if there is a limit, it will return the number of rows required to reach this limit
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, err
+}
+
+//// HYDRATE FUNCTIONS
+
+func getRedHatOpenShiftCluster(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("getRedHatOpenShiftCluster")
+
+	name := d.EqualsQuals["name"].GetStringValue()
+	resourceGroup := d.EqualsQuals["resource_group"].GetStringValue()
+
+	// Handle empty name or resourceGroup
+	if name == "" || resourceGroup == "" {
+		return nil, nil
+	}
+
+	session, err := GetNewSession(ctx, d, "MANAGEMENT")
+	if err != nil {
+		return nil, err
+	}
+	subscriptionID := session.SubscriptionID
+
+	client := redhatopenshift.NewOpenShiftClustersClientWithBaseURI(session.ResourceManagerEndpoint, subscriptionID)
+	client.Authorizer = session.Authorizer
+
+	op, err := client.Get(ctx, resourceGroup, name)
+	if err != nil {
+		plugin.Logger(ctx).Error("getRedHatOpenShiftCluster", "get", err)
+		return nil, err
+	}
+
+	// In some cases resource does not give any notFound error
+	// instead of notFound error, it returns empty data
+	if op.ID != nil {
+		return op, nil
+	}
+
+	return nil, nil
+}
+
+//// TRANSFORM FUNCTIONS
+
+func maskRedHatOpenShiftClusterProfile(ctx context.Context, d *transform.TransformData) (interface{}, error) {
+	profile, ok := d.Value.(*redhatopenshift.ClusterProfile)
+	if !ok || profile == nil {
+		return nil, nil
+	}
+
+	masked := *profile
+	masked.PullSecret = nil
+
+	return masked, nil
+}
+
+func maskRedHatOpenShiftServicePrincipalProfile(ctx context.Context, d *transform.TransformData) (interface{}, error) {
+	profile, ok := d.Value.(*redhatopenshift.ServicePrincipalProfile)
+	if !ok || profile == nil {
+		return nil, nil
+	}
+
+	masked := *profile
+	masked.ClientSecret = nil
+
+	return masked, nil
+}
@@ -2,6 +2,7 @@ package azure
 
 import (
 	"context"
+	"strings"
 
 	"github.com/Azure/azure-sdk-for-go/profiles/latest/redis/mgmt/redis"
 
@@ -26,6 +27,11 @@ func tableAzureRedisCache(_ context.Context) *plugin.Table {
 		List: &plugin.ListConfig{
 			Hydrate: listRedisCaches,
 		},
+		HydrateConfig: []plugin.HydrateConfig{
+			{
+				Func: getRedisCachePatchSchedule,
+			},
+		},
 		Columns: azureColumns([]*plugin.Column{
 			{
 				Name:        "name",
@@ -151,6 +157,13 @@ func tableAzureRedisCache(_ context.Context) *plugin.Table {
 				Type:        proto.ColumnType_JSON,
 				Transform:   transform.FromField("Properties.Instances"),
 			},
+			{
+				Name:        "patch_schedule",
+				Description: "A list of patch schedule entries for the cache.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getRedisCachePatchSchedule,
+				Transform:   transform.FromField("ScheduleEntries.ScheduleEntries"),
+			},
 			{
 				Name:        "private_endpoint_connections",
 				Description: "A list of private endpoint connection associated with the specified redis cache.",
@@ -286,3 +299,31 @@ func getRedisCache(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateDa
 
 	return op, nil
 }
+
+func getRedisCachePatchSchedule(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("getRedisCachePatchSchedule")
+
+	cache := h.Item.(redis.ResourceType)
+	resourceGroup := strings.Split(*cache.ID, "/")[4]
+
+	// Create session
+	session, err := GetNewSession(ctx, d, "MANAGEMENT")
+	if err != nil {
+		return nil, err
+	}
+	subscriptionID := session.SubscriptionID
+	client := redis.NewPatchSchedulesClientWithBaseURI(session.ResourceManagerEndpoint, subscriptionID)
+	client.Authorizer = session.Authorizer
+
+	op, err := client.Get(ctx, resourceGroup, *cache.Name)
+	if err != nil {
+		// API throws 404 error if no patch schedule is configured for the cache
+		if strings.Contains(err.Error(), "404") {
+			return nil, nil
+		}
+		plugin.Logger(ctx).Error("azure_redis_cache.getRedisCachePatchSchedule", "api_error", err)
+		return nil, err
+	}
+
+	return op, nil
+}
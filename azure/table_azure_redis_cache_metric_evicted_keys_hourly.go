@@ -0,0 +1,39 @@
+package azure
+
+import (
+	"context"
+
+	"github.com/Azure/azure-sdk-for-go/profiles/latest/redis/mgmt/redis"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAzureRedisCacheMetricEvictedKeysHourly(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "azure_redis_cache_metric_evicted_keys_hourly",
+		Description: "Azure Redis Cache Metrics - Evicted Keys (Hourly)",
+		List: &plugin.ListConfig{
+			ParentHydrate: listRedisCaches,
+			Hydrate:       listRedisCacheMetricEvictedKeysHourly,
+		},
+		Columns: monitoringMetricColumns([]*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name of the cache.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("DimensionValue").Transform(lastPathElement),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listRedisCacheMetricEvictedKeysHourly(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	cacheInfo := h.Item.(redis.ResourceType)
+
+	return listAzureMonitorMetricStatistics(ctx, d, "HOURLY", "Microsoft.Cache/Redis", "evictedkeys", *cacheInfo.ID)
+}
@@ -0,0 +1,39 @@
+package azure
+
+import (
+	"context"
+
+	"github.com/Azure/azure-sdk-for-go/profiles/latest/redis/mgmt/redis"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAzureRedisCacheMetricServerLoadDaily(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "azure_redis_cache_metric_server_load_daily",
+		Description: "Azure Redis Cache Metrics - Server Load (Daily)",
+		List: &plugin.ListConfig{
+			ParentHydrate: listRedisCaches,
+			Hydrate:       listRedisCacheMetricServerLoadDaily,
+		},
+		Columns: monitoringMetricColumns([]*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name of the cache.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("DimensionValue").Transform(lastPathElement),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listRedisCacheMetricServerLoadDaily(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	cacheInfo := h.Item.(redis.ResourceType)
+
+	return listAzureMonitorMetricStatistics(ctx, d, "DAILY", "Microsoft.Cache/Redis", "serverLoad", *cacheInfo.ID)
+}
@@ -0,0 +1,217 @@
+package azure
+
+import (
+	"context"
+
+	"github.com/Azure/azure-sdk-for-go/profiles/latest/redisenterprise/mgmt/redisenterprise"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+)
+
+//// TABLE DEFINITION
+
+func tableAzureRedisEnterpriseCluster(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "azure_redis_enterprise_cluster",
+		Description: "Azure Cache for Redis Enterprise Cluster",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.AllColumns([]string{"name", "resource_group"}),
+			Hydrate:    getRedisEnterpriseCluster,
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: isNotFoundError([]string{"ResourceNotFound", "ResourceGroupNotFound", "404"}),
+			},
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listRedisEnterpriseClusters,
+		},
+		Columns: azureColumns([]*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name of the cluster.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "id",
+				Description: "The ID of the cluster.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromGo(),
+			},
+			{
+				Name:        "type",
+				Description: "The type of the resource.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "provisioning_state",
+				Description: "The current provisioning status of the cluster. Possible values include: 'Succeeded', 'Failed', 'Canceled', 'Creating', 'Updating', 'Deleting'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ClusterProperties.ProvisioningState"),
+			},
+			{
+				Name:        "resource_state",
+				Description: "The current resource status of the cluster, e.g. Running, Creating, Updating or Disabled.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ClusterProperties.ResourceState"),
+			},
+			{
+				Name:        "host_name",
+				Description: "The DNS name of the cluster endpoint.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ClusterProperties.HostName"),
+			},
+			{
+				Name:        "redis_version",
+				Description: "The version of Redis the cluster supports, e.g. '6'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ClusterProperties.RedisVersion"),
+			},
+			{
+				Name:        "minimum_tls_version",
+				Description: "The minimum TLS version for the cluster to support. Possible values include: '1.0', '1.1', '1.2'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ClusterProperties.MinimumTLSVersion"),
+			},
+			{
+				Name:        "sku_name",
+				Description: "The type of Redis Enterprise cluster to deploy, e.g. Enterprise_E10 or EnterpriseFlash_F300.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Sku.Name"),
+			},
+			{
+				Name:        "sku_capacity",
+				Description: "The size of the cluster.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("Sku.Capacity"),
+			},
+			{
+				Name:        "zones",
+				Description: "The availability zones where the cluster is deployed.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "private_endpoint_connections",
+				Description: "The list of private endpoint connections associated with the cluster.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("ClusterProperties.PrivateEndpointConnections"),
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+			{
+				Name:        "tags",
+				Description: ColumnDescriptionTags,
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "akas",
+				Description: ColumnDescriptionAkas,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("ID").Transform(idToAkas),
+			},
+
+			// Azure standard columns
+			{
+				Name:        "region",
+				Description: ColumnDescriptionRegion,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Location").Transform(toLower),
+			},
+			{
+				Name:        "resource_group",
+				Description: ColumnDescriptionResourceGroup,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ID").Transform(extractResourceGroupFromID),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listRedisEnterpriseClusters(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	session, err := GetNewSession(ctx, d, "MANAGEMENT")
+	if err != nil {
+		return nil, err
+	}
+	subscriptionID := session.SubscriptionID
+
+	client := redisenterprise.NewClientWithBaseURI(session.ResourceManagerEndpoint, subscriptionID)
+	client.Authorizer = session.Authorizer
+
+	result, err := client.List(ctx)
+	if err != nil {
+		plugin.Logger(ctx).Error("listRedisEnterpriseClusters", "list", err)
+		return nil, err
+	}
+
+	for _, cluster := range result.Values() {
+		d.StreamListItem(ctx, cluster)
+		// Check if context has been cancelled or if the limit has been hit (if specified)
+		// if there is a limit, it will return the number of rows required to reach this limit
+		if d.RowsRemaining(ctx) == 0 {
+			return nil, nil
+		}
+	}
+
+	for result.NotDone() {
+		err = result.NextWithContext(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("listRedisEnterpriseClusters", "list_paging", err)
+			return nil, err
+		}
+		for _, cluster := range result.Values() {
+			d.StreamListItem(ctx, cluster)
+			// Check if context has been cancelled or if the limit has been hit (if specified)
+			// if there is a limit, it will return the number of rows required to reach this limit
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, err
+}
+
+//// HYDRATE FUNCTIONS
+
+func getRedisEnterpriseCluster(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("getRedisEnterpriseCluster")
+
+	name := d.EqualsQuals["name"].GetStringValue()
+	resourceGroup := d.EqualsQuals["resource_group"].GetStringValue()
+
+	// Handle empty name or resourceGroup
+	if name == "" || resourceGroup == "" {
+		return nil, nil
+	}
+
+	session, err := GetNewSession(ctx, d, "MANAGEMENT")
+	if err != nil {
+		return nil, err
+	}
+	subscriptionID := session.SubscriptionID
+
+	client := redisenterprise.NewClientWithBaseURI(session.ResourceManagerEndpoint, subscriptionID)
+	client.Authorizer = session.Authorizer
+
+	op, err := client.Get(ctx, resourceGroup, name)
+	if err != nil {
+		plugin.Logger(ctx).Error("getRedisEnterpriseCluster", "get", err)
+		return nil, err
+	}
+
+	// In some cases resource does not give any notFound error
+	// instead of notFound error, it returns empty data
+	if op.ID != nil {
+		return op, nil
+	}
+
+	return nil, nil
+}
@@ -0,0 +1,241 @@
+package azure
+
+import (
+	"context"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/profiles/latest/redisenterprise/mgmt/redisenterprise"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+)
+
+//// TABLE DEFINITION
+
+func tableAzureRedisEnterpriseDatabase(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "azure_redis_enterprise_database",
+		Description: "Azure Cache for Redis Enterprise Database",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.AllColumns([]string{"cluster_name", "name", "resource_group"}),
+			Hydrate:    getRedisEnterpriseDatabase,
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: isNotFoundError([]string{"ResourceNotFound", "ResourceGroupNotFound", "404"}),
+			},
+		},
+		List: &plugin.ListConfig{
+			Hydrate:       listRedisEnterpriseDatabases,
+			ParentHydrate: listRedisEnterpriseClusters,
+		},
+		Columns: azureColumns([]*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name of the database.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "id",
+				Description: "The ID of the database.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromGo(),
+			},
+			{
+				Name:        "cluster_name",
+				Description: "The name of the Redis Enterprise cluster the database belongs to.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "type",
+				Description: "The type of the resource.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "provisioning_state",
+				Description: "The current provisioning status of the database. Possible values include: 'Succeeded', 'Failed', 'Canceled', 'Creating', 'Updating', 'Deleting'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("DatabaseProperties.ProvisioningState"),
+			},
+			{
+				Name:        "resource_state",
+				Description: "The current resource status of the database, e.g. Running, Creating, Updating or Disabled.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("DatabaseProperties.ResourceState"),
+			},
+			{
+				Name:        "client_protocol",
+				Description: "Specifies whether Redis clients can connect using TLS-encrypted or plaintext Redis protocols. Possible values include: 'Encrypted', 'Plaintext'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("DatabaseProperties.ClientProtocol"),
+			},
+			{
+				Name:        "port",
+				Description: "The TCP port of the database endpoint.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("DatabaseProperties.Port"),
+			},
+			{
+				Name:        "clustering_policy",
+				Description: "The clustering policy of the database. Possible values include: 'EnterpriseCluster', 'OSSCluster'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("DatabaseProperties.ClusteringPolicy"),
+			},
+			{
+				Name:        "eviction_policy",
+				Description: "The Redis eviction policy of the database, e.g. AllKeysLRU or NoEviction.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("DatabaseProperties.EvictionPolicy"),
+			},
+			{
+				Name:        "persistence",
+				Description: "The persistence settings of the database, with the AOF and RDB settings.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("DatabaseProperties.Persistence"),
+			},
+			{
+				Name:        "modules",
+				Description: "The optional set of Redis modules enabled in the database.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("DatabaseProperties.Modules"),
+			},
+			{
+				Name:        "geo_replication",
+				Description: "The active geo-replication settings of the database.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("DatabaseProperties.GeoReplication"),
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+			{
+				Name:        "akas",
+				Description: ColumnDescriptionAkas,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("ID").Transform(idToAkas),
+			},
+
+			// Azure standard columns
+			{
+				Name:        "region",
+				Description: ColumnDescriptionRegion,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Location").Transform(toLower),
+			},
+			{
+				Name:        "resource_group",
+				Description: ColumnDescriptionResourceGroup,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ID").Transform(extractResourceGroupFromID),
+			},
+		}),
+	}
+}
+
+type RedisEnterpriseDatabaseInfo = struct {
+	redisenterprise.Database
+	ClusterName *string
+	Location    *string
+}
+
+//// LIST FUNCTION
+
+func listRedisEnterpriseDatabases(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	// Get the details of redis enterprise cluster
+	cluster := h.Item.(redisenterprise.Cluster)
+	resourceGroup := strings.Split(*cluster.ID, "/")[4]
+
+	session, err := GetNewSession(ctx, d, "MANAGEMENT")
+	if err != nil {
+		return nil, err
+	}
+	subscriptionID := session.SubscriptionID
+
+	client := redisenterprise.NewDatabasesClientWithBaseURI(session.ResourceManagerEndpoint, subscriptionID)
+	client.Authorizer = session.Authorizer
+
+	result, err := client.ListByCluster(ctx, resourceGroup, *cluster.Name)
+	if err != nil {
+		plugin.Logger(ctx).Error("listRedisEnterpriseDatabases", "list", err)
+		return nil, err
+	}
+
+	for _, database := range result.Values() {
+		d.StreamListItem(ctx, RedisEnterpriseDatabaseInfo{database, cluster.Name, cluster.Location})
+		// Check if context has been cancelled or if the limit has been hit (if specified)
+		// if there is a limit, it will return the number of rows required to reach this limit
+		if d.RowsRemaining(ctx) == 0 {
+			return nil, nil
+		}
+	}
+
+	for result.NotDone() {
+		err = result.NextWithContext(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("listRedisEnterpriseDatabases", "list_paging", err)
+			return nil, err
+		}
+		for _, database := range result.Values() {
+			d.StreamListItem(ctx, RedisEnterpriseDatabaseInfo{database, cluster.Name, cluster.Location})
+			// Check if context has been cancelled or if the limit has been hit (if specified)
+			// if there is a limit, it will return the number of rows required to reach this limit
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, err
+}
+
+//// HYDRATE FUNCTIONS
+
+func getRedisEnterpriseDatabase(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("getRedisEnterpriseDatabase")
+
+	clusterName := d.EqualsQuals["cluster_name"].GetStringValue()
+	name := d.EqualsQuals["name"].GetStringValue()
+	resourceGroup := d.EqualsQuals["resource_group"].GetStringValue()
+
+	// Handle empty check
+	if clusterName == "" || name == "" || resourceGroup == "" {
+		return nil, nil
+	}
+
+	session, err := GetNewSession(ctx, d, "MANAGEMENT")
+	if err != nil {
+		return nil, err
+	}
+	subscriptionID := session.SubscriptionID
+
+	client := redisenterprise.NewDatabasesClientWithBaseURI(session.ResourceManagerEndpoint, subscriptionID)
+	client.Authorizer = session.Authorizer
+
+	op, err := client.Get(ctx, resourceGroup, clusterName, name)
+	if err != nil {
+		plugin.Logger(ctx).Error("getRedisEnterpriseDatabase", "get", err)
+		return nil, err
+	}
+
+	// In some cases resource does not give any notFound error
+	// instead of notFound error, it returns empty data
+	if op.ID == nil {
+		return nil, nil
+	}
+
+	// The database does not return the location, so it is taken from the cluster
+	clusterClient := redisenterprise.NewClientWithBaseURI(session.ResourceManagerEndpoint, subscriptionID)
+	clusterClient.Authorizer = session.Authorizer
+
+	cluster, err := clusterClient.Get(ctx, resourceGroup, clusterName)
+	if err != nil {
+		plugin.Logger(ctx).Error("getRedisEnterpriseDatabase", "get_cluster", err)
+		return nil, err
+	}
+
+	return RedisEnterpriseDatabaseInfo{op, cluster.Name, cluster.Location}, nil
+}
@@ -0,0 +1,232 @@
+package azure
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/profiles/latest/resourcegraph/mgmt/resourcegraph"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+)
+
+//// TABLE DEFINITION
+
+func tableAzureResourceChange(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "azure_resource_change",
+		Description: "Azure Resource Change",
+		List: &plugin.ListConfig{
+			Hydrate: listResourceChanges,
+			KeyColumns: plugin.KeyColumnSlice{
+				{
+					Name:    "resource_id",
+					Require: plugin.Optional,
+				},
+				{
+					Name:      "timestamp",
+					Require:   plugin.Optional,
+					Operators: []string{">", ">=", "<", "<="},
+				},
+			},
+		},
+		Columns: azureColumns([]*plugin.Column{
+			{
+				Name:        "change_id",
+				Description: "The ID of the change, unique within the changed resource.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromValue().TransformP(extractGenericResourceProperty, "name"),
+			},
+			{
+				Name:        "id",
+				Description: "The fully qualified ID of the change.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromValue().TransformP(extractGenericResourceProperty, "id"),
+			},
+			{
+				Name:        "resource_id",
+				Description: "The ID of the changed resource.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromValue().TransformP(extractGenericResourceProperty, "properties.targetResourceId"),
+			},
+			{
+				Name:        "resource_name",
+				Description: "The name of the changed resource.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromValue().TransformP(extractGenericResourceProperty, "properties.targetResourceId").Transform(lastPathElement),
+			},
+			{
+				Name:        "resource_type",
+				Description: "The type of the changed resource.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromValue().TransformP(extractGenericResourceProperty, "properties.targetResourceType"),
+			},
+			{
+				Name:        "change_type",
+				Description: "The type of the change. Possible values include: 'Create', 'Update', 'Delete'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromValue().TransformP(extractGenericResourceProperty, "properties.changeType"),
+			},
+			{
+				Name:        "timestamp",
+				Description: "The time when the change was detected.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromValue().TransformP(extractGenericResourceProperty, "properties.changeAttributes.timestamp"),
+			},
+			{
+				Name:        "before_snapshot_id",
+				Description: "The ID of the snapshot of the resource before the change.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromValue().TransformP(extractGenericResourceProperty, "properties.changeAttributes.previousResourceSnapshotId"),
+			},
+			{
+				Name:        "after_snapshot_id",
+				Description: "The ID of the snapshot of the resource after the change.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromValue().TransformP(extractGenericResourceProperty, "properties.changeAttributes.newResourceSnapshotId"),
+			},
+			{
+				Name:        "changed_by",
+				Description: "The principal who made the change, e.g. a user principal name or an application ID.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromValue().TransformP(extractGenericResourceProperty, "properties.changeAttributes.changedBy"),
+			},
+			{
+				Name:        "changed_by_type",
+				Description: "The type of the principal who made the change, e.g. User or AppId.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromValue().TransformP(extractGenericResourceProperty, "properties.changeAttributes.changedByType"),
+			},
+			{
+				Name:        "client_type",
+				Description: "The client used to make the change, e.g. Azure Portal, Azure CLI or ARM Template.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromValue().TransformP(extractGenericResourceProperty, "properties.changeAttributes.clientType"),
+			},
+			{
+				Name:        "operation",
+				Description: "The operation that made the change.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromValue().TransformP(extractGenericResourceProperty, "properties.changeAttributes.operation"),
+			},
+			{
+				Name:        "correlation_id",
+				Description: "The correlation ID of the operation that made the change, which can be used to find the operation in the activity log.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromValue().TransformP(extractGenericResourceProperty, "properties.changeAttributes.correlationId"),
+			},
+			{
+				Name:        "changes_count",
+				Description: "The number of properties changed.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromValue().TransformP(extractGenericResourceProperty, "properties.changeAttributes.changesCount"),
+			},
+			{
+				Name:        "changes",
+				Description: "The changed properties, keyed by property path, with their value before and after the change.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromValue().TransformP(extractGenericResourceProperty, "properties.changes"),
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromValue().TransformP(extractGenericResourceProperty, "name"),
+			},
+
+			// Azure standard columns
+			{
+				Name:        "resource_group",
+				Description: ColumnDescriptionResourceGroup,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromValue().TransformP(extractGenericResourceProperty, "resourceGroup").Transform(toLower),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listResourceChanges(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	session, err := GetNewSession(ctx, d, "MANAGEMENT")
+	if err != nil {
+		return nil, err
+	}
+	subscriptionID := session.SubscriptionID
+
+	client := resourcegraph.NewWithBaseURI(session.ResourceManagerEndpoint)
+	client.Authorizer = session.Authorizer
+
+	// The changes are read from the resourcechanges table of Azure Resource
+	// Graph, which also records who made each change and from which client
+	query := "resourcechanges" + buildResourceChangeFilter(d.Quals) + " | order by todatetime(properties.changeAttributes.timestamp) desc"
+
+	// Resource Graph returns at most 1000 rows per page
+	top := int32(1000)
+	if d.QueryContext.Limit != nil && *d.QueryContext.Limit < int64(top) {
+		top = int32(*d.QueryContext.Limit)
+	}
+
+	request := resourcegraph.QueryRequest{
+		Subscriptions: &[]string{subscriptionID},
+		Query:         &query,
+		Options: &resourcegraph.QueryRequestOptions{
+			Top:          &top,
+			ResultFormat: resourcegraph.ResultFormatObjectArray,
+		},
+	}
+
+	for {
+		result, err := client.Resources(ctx, request)
+		if err != nil {
+			plugin.Logger(ctx).Error("listResourceChanges", "query", err)
+			return nil, err
+		}
+
+		if rows, ok := result.Data.([]interface{}); ok {
+			for _, row := range rows {
+				d.StreamListItem(ctx, row)
+				// Check if context has been cancelled or if the limit has been hit (if specified)
+				// if there is a limit, it will return the number of rows required to reach this limit
+				if d.RowsRemaining(ctx) == 0 {
+					return nil, nil
+				}
+			}
+		}
+
+		if result.SkipToken == nil || *result.SkipToken == "" {
+			break
+		}
+		request.Options.SkipToken = result.SkipToken
+	}
+
+	return nil, nil
+}
+
+//// UTILITY FUNCTION
+
+func buildResourceChangeFilter(quals plugin.KeyColumnQualMap) string {
+	filter := ""
+
+	if quals["resource_id"] != nil {
+		for _, q := range quals["resource_id"].Quals {
+			// Resource IDs are case insensitive
+			resourceID := strings.ReplaceAll(q.Value.GetStringValue(), "'", "\\'")
+			filter += fmt.Sprintf(" | where tostring(properties.targetResourceId) =~ '%s'", resourceID)
+		}
+	}
+
+	if quals["timestamp"] != nil {
+		for _, q := range quals["timestamp"].Quals {
+			timestamp := q.Value.GetTimestampValue().AsTime().Format(time.RFC3339)
+			filter += fmt.Sprintf(" | where todatetime(properties.changeAttributes.timestamp) %s datetime(%s)", q.Operator, timestamp)
+		}
+	}
+
+	return filter
+}
@@ -0,0 +1,222 @@
+package azure
+
+import (
+	"context"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/profiles/latest/network/mgmt/network"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+)
+
+//// TABLE DEFINITION
+
+func tableAzureRouteTableRoute(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "azure_route_table_route",
+		Description: "Azure Route Table Route",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.AllColumns([]string{"route_table_name", "name", "resource_group"}),
+			Hydrate:    getRouteTableRoute,
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: isNotFoundError([]string{"ResourceNotFound", "ResourceGroupNotFound", "NotFound", "404"}),
+			},
+		},
+		List: &plugin.ListConfig{
+			Hydrate:       listRouteTableRoutes,
+			ParentHydrate: listRouteTables,
+		},
+		Columns: azureColumns([]*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name of the resource that is unique within a resource group.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "id",
+				Description: "The resource ID.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromGo(),
+			},
+			{
+				Name:        "route_table_name",
+				Description: "The name of the route table the route belongs to.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "type",
+				Description: "The type of the resource.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "etag",
+				Description: "A unique read-only string that changes whenever the resource is updated.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "address_prefix",
+				Description: "The destination CIDR to which the route applies.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("RoutePropertiesFormat.AddressPrefix"),
+			},
+			{
+				Name:        "next_hop_type",
+				Description: "The type of Azure hop the packet should be sent to. Possible values include: 'VirtualNetworkGateway', 'VnetLocal', 'Internet', 'VirtualAppliance', 'None'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("RoutePropertiesFormat.NextHopType"),
+			},
+			{
+				Name:        "next_hop_ip_address",
+				Description: "The IP address packets should be forwarded to. Next hop values are only allowed in routes where the next hop type is VirtualAppliance.",
+				Type:        proto.ColumnType_IPADDR,
+				Transform:   transform.FromField("RoutePropertiesFormat.NextHopIPAddress"),
+			},
+			{
+				Name:        "provisioning_state",
+				Description: "The provisioning state of the route resource.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("RoutePropertiesFormat.ProvisioningState"),
+			},
+			{
+				Name:        "has_bgp_override",
+				Description: "A value indicating whether this route overrides overlapping BGP routes regardless of LPM.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("RoutePropertiesFormat.HasBgpOverride"),
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+			{
+				Name:        "akas",
+				Description: ColumnDescriptionAkas,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("ID").Transform(idToAkas),
+			},
+
+			// Azure standard columns
+			{
+				Name:        "region",
+				Description: ColumnDescriptionRegion,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Location").Transform(toLower),
+			},
+			{
+				Name:        "resource_group",
+				Description: ColumnDescriptionResourceGroup,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ID").Transform(extractResourceGroupFromID),
+			},
+		}),
+	}
+}
+
+type RouteTableRouteInfo = struct {
+	network.Route
+	RouteTableName *string
+	Location       *string
+}
+
+//// LIST FUNCTION
+
+func listRouteTableRoutes(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	// Get the details of route table
+	routeTable := h.Item.(network.RouteTable)
+	resourceGroup := strings.Split(*routeTable.ID, "/")[4]
+
+	session, err := GetNewSession(ctx, d, "MANAGEMENT")
+	if err != nil {
+		return nil, err
+	}
+	subscriptionID := session.SubscriptionID
+
+	client := network.NewRoutesClientWithBaseURI(session.ResourceManagerEndpoint, subscriptionID)
+	client.Authorizer = session.Authorizer
+
+	result, err := client.List(ctx, resourceGroup, *routeTable.Name)
+	if err != nil {
+		plugin.Logger(ctx).Error("listRouteTableRoutes", "list", err)
+		return nil, err
+	}
+
+	for _, route := range result.Values() {
+		d.StreamListItem(ctx, RouteTableRouteInfo{route, routeTable.Name, routeTable.Location})
+		// Check if context has been cancelled or if the limit has been hit (if specified)
+		// if there is a limit, it will return the number of rows required to reach this limit
+		if d.RowsRemaining(ctx) == 0 {
+			return nil, nil
+		}
+	}
+
+	for result.NotDone() {
+		err = result.NextWithContext(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("listRouteTableRoutes", "list_paging", err)
+			return nil, err
+		}
+		for _, route := range result.Values() {
+			d.StreamListItem(ctx, RouteTableRouteInfo{route, routeTable.Name, routeTable.Location})
+			// Check if context has been cancelled or if the limit has been hit (if specified)
+			// if there is a limit, it will return the number of rows required to reach this limit
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, err
+}
+
+//// HYDRATE FUNCTIONS
+
+func getRouteTableRoute(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("getRouteTableRoute")
+
+	routeTableName := d.EqualsQuals["route_table_name"].GetStringValue()
+	name := d.EqualsQuals["name"].GetStringValue()
+	resourceGroup := d.EqualsQuals["resource_group"].GetStringValue()
+
+	// Handle empty check
+	if routeTableName == "" || name == "" || resourceGroup == "" {
+		return nil, nil
+	}
+
+	session, err := GetNewSession(ctx, d, "MANAGEMENT")
+	if err != nil {
+		return nil, err
+	}
+	subscriptionID := session.SubscriptionID
+
+	client := network.NewRoutesClientWithBaseURI(session.ResourceManagerEndpoint, subscriptionID)
+	client.Authorizer = session.Authorizer
+
+	op, err := client.Get(ctx, resourceGroup, routeTableName, name)
+	if err != nil {
+		plugin.Logger(ctx).Error("getRouteTableRoute", "get", err)
+		return nil, err
+	}
+
+	// In some cases resource does not give any notFound error
+	// instead of notFound error, it returns empty data
+	if op.ID == nil {
+		return nil, nil
+	}
+
+	// The route does not return the location, so it is taken from the route table
+	routeTableClient := network.NewRouteTablesClientWithBaseURI(session.ResourceManagerEndpoint, subscriptionID)
+	routeTableClient.Authorizer = session.Authorizer
+
+	routeTable, err := routeTableClient.Get(ctx, resourceGroup, routeTableName, "")
+	if err != nil {
+		plugin.Logger(ctx).Error("getRouteTableRoute", "get_route_table", err)
+		return nil, err
+	}
+
+	return RouteTableRouteInfo{op, routeTable.Name, routeTable.Location}, nil
+}
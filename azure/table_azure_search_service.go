@@ -26,6 +26,13 @@ func tableAzureSearchService(_ context.Context) *plugin.Table {
 		List: &plugin.ListConfig{
 			Hydrate: listSearchServices,
 		},
+		HydrateConfig: []plugin.HydrateConfig{
+			{
+				Func:           listSearchServiceDiagnosticSettings,
+				MaxConcurrency: diagnosticSettingsMaxConcurrency,
+				Tags:           map[string]string{"service": "Microsoft.Insights", "action": "diagnosticSettings/list"},
+			},
+		},
 		Columns: azureColumns([]*plugin.Column{
 			{
 				Name:        "name",
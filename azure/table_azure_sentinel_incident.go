@@ -0,0 +1,329 @@
+package azure
+
+import (
+	"context"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/profiles/latest/operationalinsights/mgmt/operationalinsights"
+	"github.com/Azure/azure-sdk-for-go/profiles/preview/preview/securityinsight/mgmt/securityinsight"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+//// TABLE DEFINITION ////
+
+func tableAzureSentinelIncident(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "azure_sentinel_incident",
+		Description: "Azure Sentinel Incident",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.AllColumns([]string{"workspace_name", "name", "resource_group"}),
+			Hydrate:    getSentinelIncident,
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: isNotFoundError([]string{"ResourceNotFound", "ResourceGroupNotFound", "NotFound", "404"}),
+			},
+		},
+		List: &plugin.ListConfig{
+			Hydrate:       listSentinelIncidents,
+			ParentHydrate: listLogAnalyticsWorkspaces,
+			// Workspaces that are not onboarded to Microsoft Sentinel return a not found error
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: isNotFoundError([]string{"ResourceNotFound", "NotFound", "404"}),
+			},
+		},
+		Columns: azureColumns([]*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name of the incident, which is a GUID.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "id",
+				Description: "The ID of the incident.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromGo(),
+			},
+			{
+				Name:        "type",
+				Description: "The type of the resource.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "workspace_name",
+				Description: "The name of the Log Analytics workspace the incident belongs to.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "incident_number",
+				Description: "A sequential number that identifies the incident within the workspace.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("IncidentProperties.IncidentNumber"),
+			},
+			{
+				Name:        "description",
+				Description: "The description of the incident.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("IncidentProperties.Description"),
+			},
+			{
+				Name:        "severity",
+				Description: "The severity of the incident. Possible values include: 'High', 'Medium', 'Low', 'Informational'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("IncidentProperties.Severity"),
+			},
+			{
+				Name:        "status",
+				Description: "The status of the incident. Possible values include: 'New', 'Active', 'Closed'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("IncidentProperties.Status"),
+			},
+			{
+				Name:        "classification",
+				Description: "The reason the incident was closed. Possible values include: 'Undetermined', 'TruePositive', 'BenignPositive', 'FalsePositive'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("IncidentProperties.Classification"),
+			},
+			{
+				Name:        "classification_comment",
+				Description: "The comment describing why the incident was closed.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("IncidentProperties.ClassificationComment"),
+			},
+			{
+				Name:        "classification_reason",
+				Description: "The classification reason the incident was closed with. Possible values include: 'SuspiciousActivity', 'SuspiciousButExpected', 'IncorrectAlertLogic', 'InaccurateData'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("IncidentProperties.ClassificationReason"),
+			},
+			{
+				Name:        "provider_name",
+				Description: "The name of the source provider that generated the incident.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("IncidentProperties.ProviderName"),
+			},
+			{
+				Name:        "provider_incident_id",
+				Description: "The incident ID assigned by the incident provider.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("IncidentProperties.ProviderIncidentID"),
+			},
+			{
+				Name:        "incident_url",
+				Description: "The deep-link URL to the incident in the Azure portal.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("IncidentProperties.IncidentURL"),
+			},
+			{
+				Name:        "alert_count",
+				Description: "The number of alerts in the incident.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("IncidentProperties.AdditionalData.AlertsCount"),
+			},
+			{
+				Name:        "bookmark_count",
+				Description: "The number of bookmarks in the incident.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("IncidentProperties.AdditionalData.BookmarksCount"),
+			},
+			{
+				Name:        "comment_count",
+				Description: "The number of comments in the incident.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("IncidentProperties.AdditionalData.CommentsCount"),
+			},
+			{
+				Name:        "first_activity_time_utc",
+				Description: "The time of the first activity in the incident.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("IncidentProperties.FirstActivityTimeUtc").Transform(convertDateToTime),
+			},
+			{
+				Name:        "last_activity_time_utc",
+				Description: "The time of the last activity in the incident.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("IncidentProperties.LastActivityTimeUtc").Transform(convertDateToTime),
+			},
+			{
+				Name:        "created_time_utc",
+				Description: "The time the incident was created.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("IncidentProperties.CreatedTimeUtc").Transform(convertDateToTime),
+			},
+			{
+				Name:        "last_modified_time_utc",
+				Description: "The time the incident was last modified.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("IncidentProperties.LastModifiedTimeUtc").Transform(convertDateToTime),
+			},
+			{
+				Name:        "owner",
+				Description: "The user or group the incident is assigned to.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("IncidentProperties.Owner"),
+			},
+			{
+				Name:        "labels",
+				Description: "The labels of the incident.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("IncidentProperties.Labels"),
+			},
+			{
+				Name:        "alert_product_names",
+				Description: "The names of the products of the alerts in the incident.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("IncidentProperties.AdditionalData.AlertProductNames"),
+			},
+			{
+				Name:        "tactics",
+				Description: "The MITRE ATT&CK tactics of the alerts in the incident.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("IncidentProperties.AdditionalData.Tactics"),
+			},
+			{
+				Name:        "related_analytic_rule_ids",
+				Description: "The IDs of the analytics rules that generated the alerts of the incident.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("IncidentProperties.RelatedAnalyticRuleIds"),
+			},
+			{
+				Name:        "team_information",
+				Description: "The Microsoft Teams team created for the incident, if any.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("IncidentProperties.TeamInformation"),
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: "The title of the incident.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("IncidentProperties.Title"),
+			},
+			{
+				Name:        "akas",
+				Description: ColumnDescriptionAkas,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("ID").Transform(idToAkas),
+			},
+
+			// Azure standard columns
+			{
+				Name:        "region",
+				Description: ColumnDescriptionRegion,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Location").Transform(toLower),
+			},
+			{
+				Name:        "resource_group",
+				Description: ColumnDescriptionResourceGroup,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ID").Transform(extractResourceGroupFromID),
+			},
+		}),
+	}
+}
+
+type SentinelIncidentInfo = struct {
+	securityinsight.Incident
+	WorkspaceName *string
+	Location      *string
+}
+
+//// LIST FUNCTION ////
+
+func listSentinelIncidents(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	logger := plugin.Logger(ctx)
+
+	workspace := h.Item.(operationalinsights.Workspace)
+	resourceGroup := strings.Split(*workspace.ID, "/")[4]
+
+	session, err := GetNewSession(ctx, d, "MANAGEMENT")
+	if err != nil {
+		logger.Error("azure_sentinel_incident.listSentinelIncidents", "connection_error", err)
+		return nil, err
+	}
+	subscriptionID := session.SubscriptionID
+
+	client := securityinsight.NewIncidentsClientWithBaseURI(session.ResourceManagerEndpoint, subscriptionID)
+	client.Authorizer = session.Authorizer
+
+	result, err := client.List(ctx, resourceGroup, *workspace.Name, "", "", nil, "")
+	if err != nil {
+		logger.Error("azure_sentinel_incident.listSentinelIncidents", "api_error", err)
+		return nil, err
+	}
+
+	for _, incident := range result.Values() {
+		d.StreamListItem(ctx, SentinelIncidentInfo{incident, workspace.Name, workspace.Location})
+		// Check if context has been cancelled or if the limit has been hit (if specified)
+		if d.RowsRemaining(ctx) == 0 {
+			return nil, nil
+		}
+	}
+
+	for result.NotDone() {
+		err = result.NextWithContext(ctx)
+		if err != nil {
+			logger.Error("azure_sentinel_incident.listSentinelIncidents", "paging_error", err)
+			return nil, err
+		}
+		for _, incident := range result.Values() {
+			d.StreamListItem(ctx, SentinelIncidentInfo{incident, workspace.Name, workspace.Location})
+			// Check if context has been cancelled or if the limit has been hit (if specified)
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS ////
+
+func getSentinelIncident(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	logger := plugin.Logger(ctx)
+
+	workspaceName := d.EqualsQuals["workspace_name"].GetStringValue()
+	name := d.EqualsQuals["name"].GetStringValue()
+	resourceGroup := d.EqualsQuals["resource_group"].GetStringValue()
+
+	if workspaceName == "" || name == "" || resourceGroup == "" {
+		return nil, nil
+	}
+
+	session, err := GetNewSession(ctx, d, "MANAGEMENT")
+	if err != nil {
+		logger.Error("azure_sentinel_incident.getSentinelIncident", "connection_error", err)
+		return nil, err
+	}
+	subscriptionID := session.SubscriptionID
+
+	client := securityinsight.NewIncidentsClientWithBaseURI(session.ResourceManagerEndpoint, subscriptionID)
+	client.Authorizer = session.Authorizer
+
+	op, err := client.Get(ctx, resourceGroup, workspaceName, name)
+	if err != nil {
+		logger.Error("azure_sentinel_incident.getSentinelIncident", "api_error", err)
+		return nil, err
+	}
+
+	// In some cases resource does not give any notFound error
+	// instead of notFound error, it returns empty data
+	if op.ID == nil {
+		return nil, nil
+	}
+
+	// The incident does not return the location, so it is taken from the workspace
+	workspaceClient := operationalinsights.NewWorkspacesClientWithBaseURI(session.ResourceManagerEndpoint, subscriptionID)
+	workspaceClient.Authorizer = session.Authorizer
+
+	workspace, err := workspaceClient.Get(ctx, resourceGroup, workspaceName)
+	if err != nil {
+		logger.Error("azure_sentinel_incident.getSentinelIncident", "get_workspace_error", err)
+		return nil, err
+	}
+
+	return SentinelIncidentInfo{op, workspace.Name, workspace.Location}, nil
+}
@@ -0,0 +1,362 @@
+package azure
+
+import (
+	"context"
+
+	"github.com/Azure/azure-sdk-for-go/profiles/latest/resources/mgmt/resources"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+)
+
+// The Azure SDK version used by the plugin does not provide a client for
+// Service Fabric managed clusters, so they are read as generic resources
+const serviceFabricManagedClusterAPIVersion = "2024-04-01"
+
+//// TABLE DEFINITION
+
+func tableAzureServiceFabricManagedCluster(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "azure_service_fabric_managed_cluster",
+		Description: "Azure Service Fabric Managed Cluster",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.AllColumns([]string{"name", "resource_group"}),
+			Hydrate:    getServiceFabricManagedCluster,
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: isNotFoundError([]string{"ResourceNotFound", "ResourceGroupNotFound", "404"}),
+			},
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listServiceFabricManagedClusters,
+		},
+		Columns: azureColumns([]*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name of the managed cluster.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "id",
+				Description: "The ID of the managed cluster.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromGo(),
+			},
+			{
+				Name:        "type",
+				Description: "The type of the resource.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "provisioning_state",
+				Description: "The provisioning state of the managed cluster resource.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties").TransformP(extractGenericResourceProperty, "provisioningState"),
+			},
+			{
+				Name:        "cluster_state",
+				Description: "The current state of the cluster, e.g. WaitingForNodes, Deploying, Ready or Upgrading.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties").TransformP(extractGenericResourceProperty, "clusterState"),
+			},
+			{
+				Name:        "cluster_id",
+				Description: "A service generated unique identifier for the cluster resource.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties").TransformP(extractGenericResourceProperty, "clusterId"),
+			},
+			{
+				Name:        "cluster_code_version",
+				Description: "The Service Fabric runtime version of the cluster.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties").TransformP(extractGenericResourceProperty, "clusterCodeVersion"),
+			},
+			{
+				Name:        "cluster_upgrade_mode",
+				Description: "The upgrade mode of the cluster when a new Service Fabric runtime version is available. Possible values include: 'Automatic', 'Manual'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties").TransformP(extractGenericResourceProperty, "clusterUpgradeMode"),
+			},
+			{
+				Name:        "cluster_upgrade_cadence",
+				Description: "Indicates when new cluster runtime version upgrades will be applied after they are released.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties").TransformP(extractGenericResourceProperty, "clusterUpgradeCadence"),
+			},
+			{
+				Name:        "sku_name",
+				Description: "The SKU name of the managed cluster. Possible values include: 'Basic', 'Standard'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Sku.Name"),
+			},
+			{
+				Name:        "admin_username",
+				Description: "The VM admin user name.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties").TransformP(extractGenericResourceProperty, "adminUserName"),
+			},
+			{
+				Name:        "dns_name",
+				Description: "The cluster DNS name.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties").TransformP(extractGenericResourceProperty, "dnsName"),
+			},
+			{
+				Name:        "fqdn",
+				Description: "The fully qualified domain name associated with the public load balancer of the cluster.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties").TransformP(extractGenericResourceProperty, "fqdn"),
+			},
+			{
+				Name:        "ip_v4_address",
+				Description: "The IPv4 address associated with the public load balancer of the cluster.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties").TransformP(extractGenericResourceProperty, "ipv4Address"),
+			},
+			{
+				Name:        "ip_v6_address",
+				Description: "The IPv6 address associated with the public load balancer of the cluster.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties").TransformP(extractGenericResourceProperty, "ipv6Address"),
+			},
+			{
+				Name:        "client_connection_port",
+				Description: "The port used for client connections to the cluster.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("Properties").TransformP(extractGenericResourceProperty, "clientConnectionPort"),
+			},
+			{
+				Name:        "http_gateway_connection_port",
+				Description: "The port used for HTTP connections to the cluster.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("Properties").TransformP(extractGenericResourceProperty, "httpGatewayConnectionPort"),
+			},
+			{
+				Name:        "enable_ipv6",
+				Description: "Indicates whether the cluster is set up with IPv6 addresses.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("Properties").TransformP(extractGenericResourceProperty, "enableIpv6"),
+			},
+			{
+				Name:        "zone_resilient",
+				Description: "Indicates whether the cluster has zone resiliency.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("Properties").TransformP(extractGenericResourceProperty, "zonalResiliency"),
+			},
+			{
+				Name:        "allow_rdp_access",
+				Description: "Indicates whether RDP access to the VMs in the cluster is allowed.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("Properties").TransformP(extractGenericResourceProperty, "allowRdpAccess"),
+			},
+			{
+				Name:        "enable_auto_os_upgrade",
+				Description: "Indicates whether the cluster nodes are automatically upgraded when a new OS image is available.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("Properties").TransformP(extractGenericResourceProperty, "enableAutoOSUpgrade"),
+			},
+			{
+				Name:        "enable_service_public_ip",
+				Description: "Indicates whether the public IP of the cluster is used for the services as well.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("Properties").TransformP(extractGenericResourceProperty, "enableServicePublicIP"),
+			},
+			{
+				Name:        "use_custom_vnet",
+				Description: "Indicates whether the cluster uses a virtual network provided by the customer.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("Properties").TransformP(extractGenericResourceProperty, "useCustomVnet"),
+			},
+			{
+				Name:        "subnet_id",
+				Description: "The ID of the subnet the cluster is deployed in, when a custom virtual network is used.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties").TransformP(extractGenericResourceProperty, "subnetId"),
+			},
+			{
+				Name:        "public_ip_prefix_id",
+				Description: "The ID of the public IP prefix the load balancer of the cluster allocates its public IP address from.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties").TransformP(extractGenericResourceProperty, "publicIPPrefixId"),
+			},
+			{
+				Name:        "cluster_certificate_thumbprints",
+				Description: "The list of cluster certificate thumbprints used for cluster to cluster communication.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Properties").TransformP(extractGenericResourceProperty, "clusterCertificateThumbprints"),
+			},
+			{
+				Name:        "clients",
+				Description: "The client certificates that are allowed to manage the cluster.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Properties").TransformP(extractGenericResourceProperty, "clients"),
+			},
+			{
+				Name:        "azure_active_directory",
+				Description: "The Azure Active Directory authentication settings of the cluster.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Properties").TransformP(extractGenericResourceProperty, "azureActiveDirectory"),
+			},
+			{
+				Name:        "fabric_settings",
+				Description: "The list of custom fabric settings to configure the cluster.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Properties").TransformP(extractGenericResourceProperty, "fabricSettings"),
+			},
+			{
+				Name:        "addon_features",
+				Description: "The list of add-on features enabled on the cluster.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Properties").TransformP(extractGenericResourceProperty, "addonFeatures"),
+			},
+			{
+				Name:        "auxiliary_subnets",
+				Description: "The auxiliary subnets of the cluster.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Properties").TransformP(extractGenericResourceProperty, "auxiliarySubnets"),
+			},
+			{
+				Name:        "service_endpoints",
+				Description: "The service endpoints for the subnet of the cluster.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Properties").TransformP(extractGenericResourceProperty, "serviceEndpoints"),
+			},
+			{
+				Name:        "load_balancing_rules",
+				Description: "The load balancing rules applied to the public load balancer of the cluster.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Properties").TransformP(extractGenericResourceProperty, "loadBalancingRules"),
+			},
+			{
+				Name:        "network_security_rules",
+				Description: "The custom network security rules applied to the virtual network of the cluster.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Properties").TransformP(extractGenericResourceProperty, "networkSecurityRules"),
+			},
+			{
+				Name:        "ip_tags",
+				Description: "The list of IP tags associated with the default public IP address of the cluster.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Properties").TransformP(extractGenericResourceProperty, "ipTags"),
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+			{
+				Name:        "tags",
+				Description: ColumnDescriptionTags,
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "akas",
+				Description: ColumnDescriptionAkas,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("ID").Transform(idToAkas),
+			},
+
+			// Azure standard columns
+			{
+				Name:        "region",
+				Description: ColumnDescriptionRegion,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Location").Transform(toLower),
+			},
+			{
+				Name:        "resource_group",
+				Description: ColumnDescriptionResourceGroup,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ID").Transform(extractResourceGroupFromID),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listServiceFabricManagedClusters(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	session, err := GetNewSession(ctx, d, "MANAGEMENT")
+	if err != nil {
+		return nil, err
+	}
+	subscriptionID := session.SubscriptionID
+
+	client := resources.NewClientWithBaseURI(session.ResourceManagerEndpoint, subscriptionID)
+	client.Authorizer = session.Authorizer
+
+	// The resource list does not return the properties of the resources, so
+	// each cluster is fetched by its ID
+	result, err := client.List(ctx, "resourceType eq 'Microsoft.ServiceFabric/managedClusters'", "", nil)
+	if err != nil {
+		plugin.Logger(ctx).Error("listServiceFabricManagedClusters", "list", err)
+		return nil, err
+	}
+
+	for {
+		for _, resource := range result.Values() {
+			cluster, err := client.GetByID(ctx, *resource.ID, serviceFabricManagedClusterAPIVersion)
+			if err != nil {
+				plugin.Logger(ctx).Error("listServiceFabricManagedClusters", "get", err)
+				return nil, err
+			}
+			d.StreamListItem(ctx, cluster)
+			// Check if context has been cancelled or if the limit has been hit (if specified)
+			// if there is a limit, it will return the number of rows required to reach this limit
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+
+		if !result.NotDone() {
+			break
+		}
+		err = result.NextWithContext(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("listServiceFabricManagedClusters", "list_paging", err)
+			return nil, err
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getServiceFabricManagedCluster(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("getServiceFabricManagedCluster")
+
+	name := d.EqualsQuals["name"].GetStringValue()
+	resourceGroup := d.EqualsQuals["resource_group"].GetStringValue()
+
+	// Handle empty name or resourceGroup
+	if name == "" || resourceGroup == "" {
+		return nil, nil
+	}
+
+	session, err := GetNewSession(ctx, d, "MANAGEMENT")
+	if err != nil {
+		return nil, err
+	}
+	subscriptionID := session.SubscriptionID
+
+	client := resources.NewClientWithBaseURI(session.ResourceManagerEndpoint, subscriptionID)
+	client.Authorizer = session.Authorizer
+
+	op, err := client.Get(ctx, resourceGroup, "Microsoft.ServiceFabric", "", "managedClusters", name, serviceFabricManagedClusterAPIVersion)
+	if err != nil {
+		plugin.Logger(ctx).Error("getServiceFabricManagedCluster", "get", err)
+		return nil, err
+	}
+
+	// In some cases resource does not give any notFound error
+	// instead of notFound error, it returns empty data
+	if op.ID != nil {
+		return op, nil
+	}
+
+	return nil, nil
+}
@@ -28,6 +28,13 @@ func tableAzureServiceBusNamespace(_ context.Context) *plugin.Table {
 		List: &plugin.ListConfig{
 			Hydrate: listServiceBusNamespaces,
 		},
+		HydrateConfig: []plugin.HydrateConfig{
+			{
+				Func:           listServiceBusNamespaceDiagnosticSettings,
+				MaxConcurrency: diagnosticSettingsMaxConcurrency,
+				Tags:           map[string]string{"service": "Microsoft.Insights", "action": "diagnosticSettings/list"},
+			},
+		},
 		Columns: azureColumns([]*plugin.Column{
 			{
 				Name:        "name",
@@ -118,6 +125,13 @@ func tableAzureServiceBusNamespace(_ context.Context) *plugin.Table {
 				Hydrate:     listServiceBusNamespaceDiagnosticSettings,
 				Transform:   transform.FromValue(),
 			},
+			{
+				Name:        "disaster_recovery_configs",
+				Description: "The geo-disaster recovery configurations of the namespace, with the partner namespace and the role of the namespace in the pairing.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     listServiceBusNamespaceDisasterRecoveryConfigs,
+				Transform:   transform.FromValue(),
+			},
 			{
 				Name:        "encryption",
 				Description: "Specifies the properties of BYOK encryption configuration. Customer-managed key encryption at rest (Bring Your Own Key) is only available on Premium namespaces.",
@@ -408,6 +422,46 @@ func listServiceBusNamespaceAuthorizationRules(ctx context.Context, d *plugin.Qu
 	return serviceBusNamespaceAuthorizationRules, nil
 }
 
+func listServiceBusNamespaceDisasterRecoveryConfigs(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	namespace := h.Item.(servicebus.SBNamespace)
+	resourceGroup := strings.Split(string(*namespace.ID), "/")[4]
+	namespaceName := *namespace.Name
+
+	session, err := GetNewSession(ctx, d, "MANAGEMENT")
+	if err != nil {
+		return nil, err
+	}
+	subscriptionID := session.SubscriptionID
+
+	client := servicebus.NewDisasterRecoveryConfigsClientWithBaseURI(session.ResourceManagerEndpoint, subscriptionID)
+	client.Authorizer = session.Authorizer
+
+	op, err := client.List(ctx, resourceGroup, namespaceName)
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_servicebus_namespace.listServiceBusNamespaceDisasterRecoveryConfigs", "api_error", err)
+		return nil, err
+	}
+
+	var disasterRecoveryConfigs []map[string]interface{}
+
+	for _, c := range op.Values() {
+		disasterRecoveryConfigs = append(disasterRecoveryConfigs, extractServiceBusNamespaceDisasterRecoveryConfig(c))
+	}
+
+	for op.NotDone() {
+		err = op.NextWithContext(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("azure_servicebus_namespace.listServiceBusNamespaceDisasterRecoveryConfigs", "paging_error", err)
+			return nil, err
+		}
+		for _, c := range op.Values() {
+			disasterRecoveryConfigs = append(disasterRecoveryConfigs, extractServiceBusNamespaceDisasterRecoveryConfig(c))
+		}
+	}
+
+	return disasterRecoveryConfigs, nil
+}
+
 // If we return the API response directly, the output will not provide the properties of AuthorizationRuleProperties
 func extractServiceBusNamespacAuthRule(i servicebus.SBAuthorizationRule) map[string]interface{} {
 	serviceBusNamespaceAuthRule := make(map[string]interface{})
@@ -433,6 +487,40 @@ func extractServiceBusNamespacAuthRule(i servicebus.SBAuthorizationRule) map[str
 	return serviceBusNamespaceAuthRule
 }
 
+// If we return the API response directly, the output will not provide the read-only properties of ArmDisasterRecoveryProperties
+func extractServiceBusNamespaceDisasterRecoveryConfig(i servicebus.ArmDisasterRecovery) map[string]interface{} {
+	disasterRecoveryConfig := make(map[string]interface{})
+	if i.ID != nil {
+		disasterRecoveryConfig["id"] = *i.ID
+	}
+	if i.Name != nil {
+		disasterRecoveryConfig["name"] = *i.Name
+	}
+	if i.Type != nil {
+		disasterRecoveryConfig["type"] = *i.Type
+	}
+	if i.ArmDisasterRecoveryProperties != nil {
+		properties := make(map[string]interface{})
+		if i.ProvisioningState != "" {
+			properties["provisioningState"] = i.ProvisioningState
+		}
+		if i.PendingReplicationOperationsCount != nil {
+			properties["pendingReplicationOperationsCount"] = *i.PendingReplicationOperationsCount
+		}
+		if i.PartnerNamespace != nil {
+			properties["partnerNamespace"] = *i.PartnerNamespace
+		}
+		if i.AlternateName != nil {
+			properties["alternateName"] = *i.AlternateName
+		}
+		if i.Role != "" {
+			properties["role"] = i.Role
+		}
+		disasterRecoveryConfig["properties"] = properties
+	}
+	return disasterRecoveryConfig
+}
+
 // If we return the API response directly, the output will not provide the properties of PrivateEndpointConnections
 func extractServiceBusNamespacePrivateEndpointConnection(i servicebus.PrivateEndpointConnection) map[string]interface{} {
 	serviceBusNamespacePrivateEndpointConnection := make(map[string]interface{})
@@ -28,6 +28,13 @@ func tableAzureSignalRService(_ context.Context) *plugin.Table {
 		List: &plugin.ListConfig{
 			Hydrate: listSignalRServices,
 		},
+		HydrateConfig: []plugin.HydrateConfig{
+			{
+				Func:           listSignalRServiceDiagnosticSettings,
+				MaxConcurrency: diagnosticSettingsMaxConcurrency,
+				Tags:           map[string]string{"service": "Microsoft.Insights", "action": "diagnosticSettings/list"},
+			},
+		},
 		Columns: azureColumns([]*plugin.Column{
 			{
 				Name:        "name",
@@ -0,0 +1,263 @@
+package azure
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/runtime"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+)
+
+// The Azure SDK version used by the plugin does not provide a client for
+// Azure Sphere, so the resources are read through the ARM REST API
+const sphereAPIVersion = "2024-04-01"
+
+type sphereResource struct {
+	ID         *string                `json:"id,omitempty"`
+	Name       *string                `json:"name,omitempty"`
+	Type       *string                `json:"type,omitempty"`
+	Location   *string                `json:"location,omitempty"`
+	Tags       map[string]*string     `json:"tags,omitempty"`
+	Properties map[string]interface{} `json:"properties,omitempty"`
+	SystemData map[string]interface{} `json:"systemData,omitempty"`
+}
+
+type sphereResourceList struct {
+	Value    []sphereResource `json:"value,omitempty"`
+	NextLink *string          `json:"nextLink,omitempty"`
+}
+
+//// TABLE DEFINITION
+
+func tableAzureSphereCatalog(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "azure_sphere_catalog",
+		Description: "Azure Sphere Catalog",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.AllColumns([]string{"name", "resource_group"}),
+			Hydrate:    getSphereCatalog,
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: isNotFoundError([]string{"ResourceNotFound", "ResourceGroupNotFound", "404"}),
+			},
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listSphereCatalogs,
+		},
+		Columns: azureColumns([]*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name of the catalog.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "id",
+				Description: "The ID of the catalog.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromGo(),
+			},
+			{
+				Name:        "type",
+				Description: "The type of the resource.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "provisioning_state",
+				Description: "The provisioning state of the catalog.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties").TransformP(extractGenericResourceProperty, "provisioningState"),
+			},
+			{
+				Name:        "tenant_id",
+				Description: "The Azure Sphere tenant ID associated with the catalog.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties").TransformP(extractGenericResourceProperty, "tenantId"),
+			},
+			{
+				Name:        "system_data",
+				Description: "The metadata relating to the creation and last modification of the catalog.",
+				Type:        proto.ColumnType_JSON,
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+			{
+				Name:        "tags",
+				Description: ColumnDescriptionTags,
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "akas",
+				Description: ColumnDescriptionAkas,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("ID").Transform(idToAkas),
+			},
+
+			// Azure standard columns
+			{
+				Name:        "region",
+				Description: ColumnDescriptionRegion,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Location").Transform(toLower),
+			},
+			{
+				Name:        "resource_group",
+				Description: ColumnDescriptionResourceGroup,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ID").Transform(extractResourceGroupFromID),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listSphereCatalogs(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	session, err := GetNewSessionUpdated(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_sphere_catalog.listSphereCatalogs", "session_error", err)
+		return nil, err
+	}
+
+	client, err := arm.NewClient("azure_sphere_catalog", "v1.0.0", session.Cred, session.ClientOptions)
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_sphere_catalog.listSphereCatalogs", "client_error", err)
+		return nil, err
+	}
+
+	path := fmt.Sprintf("/subscriptions/%s/providers/Microsoft.AzureSphere/catalogs", session.SubscriptionID)
+	catalogs, err := listSphereResources(ctx, client, path)
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_sphere_catalog.listSphereCatalogs", "api_error", err)
+		return nil, err
+	}
+
+	for _, catalog := range catalogs {
+		d.StreamListItem(ctx, catalog)
+		// Check if context has been cancelled or if the limit has been hit (if specified)
+		// if there is a limit, it will return the number of rows required to reach this limit
+		if d.RowsRemaining(ctx) == 0 {
+			return nil, nil
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getSphereCatalog(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	name := d.EqualsQualString("name")
+	resourceGroup := d.EqualsQualString("resource_group")
+
+	// Handle empty name or resourceGroup
+	if name == "" || resourceGroup == "" {
+		return nil, nil
+	}
+
+	session, err := GetNewSessionUpdated(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_sphere_catalog.getSphereCatalog", "session_error", err)
+		return nil, err
+	}
+
+	client, err := arm.NewClient("azure_sphere_catalog", "v1.0.0", session.Cred, session.ClientOptions)
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_sphere_catalog.getSphereCatalog", "client_error", err)
+		return nil, err
+	}
+
+	path := fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.AzureSphere/catalogs/%s", session.SubscriptionID, resourceGroup, name)
+	catalog, err := getSphereResource(ctx, client, path)
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_sphere_catalog.getSphereCatalog", "api_error", err)
+		return nil, err
+	}
+
+	// In some cases resource does not give any notFound error
+	// instead of notFound error, it returns empty data
+	if catalog.ID == nil {
+		return nil, nil
+	}
+
+	return *catalog, nil
+}
+
+//// UTILITY FUNCTIONS
+
+// listSphereResources returns all the resources of the collection at the given path, following the next links
+func listSphereResources(ctx context.Context, client *arm.Client, path string) ([]sphereResource, error) {
+	var items []sphereResource
+
+	endpoint := runtime.JoinPaths(client.Endpoint(), path)
+	for endpoint != "" {
+		req, err := runtime.NewRequest(ctx, http.MethodGet, endpoint)
+		if err != nil {
+			return nil, err
+		}
+		// The next links already carry the api-version query parameter
+		if req.Raw().URL.Query().Get("api-version") == "" {
+			query := req.Raw().URL.Query()
+			query.Set("api-version", sphereAPIVersion)
+			req.Raw().URL.RawQuery = query.Encode()
+		}
+		req.Raw().Header["Accept"] = []string{"application/json"}
+
+		resp, err := client.Pipeline().Do(req)
+		if err != nil {
+			return nil, err
+		}
+		if !runtime.HasStatusCode(resp, http.StatusOK) {
+			return nil, runtime.NewResponseError(resp)
+		}
+
+		var result sphereResourceList
+		if err := runtime.UnmarshalAsJSON(resp, &result); err != nil {
+			return nil, err
+		}
+		items = append(items, result.Value...)
+
+		endpoint = ""
+		if result.NextLink != nil {
+			endpoint = *result.NextLink
+		}
+	}
+
+	return items, nil
+}
+
+// getSphereResource returns the resource at the given path
+func getSphereResource(ctx context.Context, client *arm.Client, path string) (*sphereResource, error) {
+	req, err := runtime.NewRequest(ctx, http.MethodGet, runtime.JoinPaths(client.Endpoint(), path))
+	if err != nil {
+		return nil, err
+	}
+	query := req.Raw().URL.Query()
+	query.Set("api-version", sphereAPIVersion)
+	req.Raw().URL.RawQuery = query.Encode()
+	req.Raw().Header["Accept"] = []string{"application/json"}
+
+	resp, err := client.Pipeline().Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if !runtime.HasStatusCode(resp, http.StatusOK) {
+		return nil, runtime.NewResponseError(resp)
+	}
+
+	var result sphereResource
+	if err := runtime.UnmarshalAsJSON(resp, &result); err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
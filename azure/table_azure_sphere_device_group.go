@@ -0,0 +1,235 @@
+package azure
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+)
+
+//// TABLE DEFINITION
+
+func tableAzureSphereDeviceGroup(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "azure_sphere_device_group",
+		Description: "Azure Sphere Device Group",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.AllColumns([]string{"catalog_name", "product_name", "name", "resource_group"}),
+			Hydrate:    getSphereDeviceGroup,
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: isNotFoundError([]string{"ResourceNotFound", "ResourceGroupNotFound", "404"}),
+			},
+		},
+		List: &plugin.ListConfig{
+			ParentHydrate: listSphereCatalogs,
+			Hydrate:       listSphereDeviceGroups,
+		},
+		Columns: azureColumns([]*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name of the device group.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "id",
+				Description: "The ID of the device group.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromGo(),
+			},
+			{
+				Name:        "catalog_name",
+				Description: "The name of the catalog the device group belongs to.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "product_name",
+				Description: "The name of the product the device group belongs to.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "type",
+				Description: "The type of the resource.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "provisioning_state",
+				Description: "The provisioning state of the device group.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties").TransformP(extractGenericResourceProperty, "provisioningState"),
+			},
+			{
+				Name:        "description",
+				Description: "The description of the device group.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties").TransformP(extractGenericResourceProperty, "description"),
+			},
+			{
+				Name:        "os_feed_type",
+				Description: "The operating system feed type of the device group. Possible values include: 'Retail', 'RetailEval'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties").TransformP(extractGenericResourceProperty, "osFeedType"),
+			},
+			{
+				Name:        "update_policy",
+				Description: "The update policy of the device group. Possible values include: 'UpdateAll', 'No3rdPartyAppUpdates'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties").TransformP(extractGenericResourceProperty, "updatePolicy"),
+			},
+			{
+				Name:        "allow_crash_dumps_collection",
+				Description: "Indicates whether crash dump collection is allowed for the device group. Possible values include: 'Enabled', 'Disabled'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties").TransformP(extractGenericResourceProperty, "allowCrashDumpsCollection"),
+			},
+			{
+				Name:        "regional_data_boundary",
+				Description: "The regional data boundary of the device group. Possible values include: 'None', 'EU'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties").TransformP(extractGenericResourceProperty, "regionalDataBoundary"),
+			},
+			{
+				Name:        "has_deployment",
+				Description: "Indicates whether the device group has a deployment.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("Properties").TransformP(extractGenericResourceProperty, "hasDeployment"),
+			},
+			{
+				Name:        "system_data",
+				Description: "The metadata relating to the creation and last modification of the device group.",
+				Type:        proto.ColumnType_JSON,
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+			{
+				Name:        "akas",
+				Description: ColumnDescriptionAkas,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("ID").Transform(idToAkas),
+			},
+
+			// Azure standard columns
+			{
+				Name:        "region",
+				Description: ColumnDescriptionRegion,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Location").Transform(toLower),
+			},
+			{
+				Name:        "resource_group",
+				Description: ColumnDescriptionResourceGroup,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ID").Transform(extractResourceGroupFromID),
+			},
+		}),
+	}
+}
+
+type SphereDeviceGroupInfo = struct {
+	sphereResource
+	CatalogName *string
+	ProductName *string
+}
+
+//// LIST FUNCTION
+
+func listSphereDeviceGroups(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	catalog := h.Item.(sphereResource)
+
+	session, err := GetNewSessionUpdated(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_sphere_device_group.listSphereDeviceGroups", "session_error", err)
+		return nil, err
+	}
+
+	client, err := arm.NewClient("azure_sphere_device_group", "v1.0.0", session.Cred, session.ClientOptions)
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_sphere_device_group.listSphereDeviceGroups", "client_error", err)
+		return nil, err
+	}
+
+	products, err := listSphereResources(ctx, client, *catalog.ID+"/products")
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_sphere_device_group.listSphereDeviceGroups", "api_error", err)
+		return nil, err
+	}
+
+	for _, product := range products {
+		deviceGroups, err := listSphereResources(ctx, client, *product.ID+"/deviceGroups")
+		if err != nil {
+			plugin.Logger(ctx).Error("azure_sphere_device_group.listSphereDeviceGroups", "api_error", err)
+			return nil, err
+		}
+
+		for _, deviceGroup := range deviceGroups {
+			// Device groups are proxy resources, so the location is taken from the catalog
+			deviceGroup.Location = catalog.Location
+			d.StreamListItem(ctx, SphereDeviceGroupInfo{deviceGroup, catalog.Name, product.Name})
+			// Check if context has been cancelled or if the limit has been hit (if specified)
+			// if there is a limit, it will return the number of rows required to reach this limit
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getSphereDeviceGroup(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	catalogName := d.EqualsQualString("catalog_name")
+	productName := d.EqualsQualString("product_name")
+	name := d.EqualsQualString("name")
+	resourceGroup := d.EqualsQualString("resource_group")
+
+	// Handle empty check
+	if catalogName == "" || productName == "" || name == "" || resourceGroup == "" {
+		return nil, nil
+	}
+
+	session, err := GetNewSessionUpdated(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_sphere_device_group.getSphereDeviceGroup", "session_error", err)
+		return nil, err
+	}
+
+	client, err := arm.NewClient("azure_sphere_device_group", "v1.0.0", session.Cred, session.ClientOptions)
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_sphere_device_group.getSphereDeviceGroup", "client_error", err)
+		return nil, err
+	}
+
+	catalogPath := fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.AzureSphere/catalogs/%s", session.SubscriptionID, resourceGroup, catalogName)
+	deviceGroup, err := getSphereResource(ctx, client, catalogPath+"/products/"+productName+"/deviceGroups/"+name)
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_sphere_device_group.getSphereDeviceGroup", "api_error", err)
+		return nil, err
+	}
+
+	// In some cases resource does not give any notFound error
+	// instead of notFound error, it returns empty data
+	if deviceGroup.ID == nil {
+		return nil, nil
+	}
+
+	// Device groups are proxy resources, so the location is taken from the catalog
+	catalog, err := getSphereResource(ctx, client, catalogPath)
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_sphere_device_group.getSphereDeviceGroup", "api_error", err)
+		return nil, err
+	}
+	deviceGroup.Location = catalog.Location
+
+	return SphereDeviceGroupInfo{*deviceGroup, catalog.Name, &productName}, nil
+}
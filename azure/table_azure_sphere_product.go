@@ -0,0 +1,190 @@
+package azure
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+)
+
+//// TABLE DEFINITION
+
+func tableAzureSphereProduct(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "azure_sphere_product",
+		Description: "Azure Sphere Product",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.AllColumns([]string{"catalog_name", "name", "resource_group"}),
+			Hydrate:    getSphereProduct,
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: isNotFoundError([]string{"ResourceNotFound", "ResourceGroupNotFound", "404"}),
+			},
+		},
+		List: &plugin.ListConfig{
+			ParentHydrate: listSphereCatalogs,
+			Hydrate:       listSphereProducts,
+		},
+		Columns: azureColumns([]*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name of the product.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "id",
+				Description: "The ID of the product.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromGo(),
+			},
+			{
+				Name:        "catalog_name",
+				Description: "The name of the catalog the product belongs to.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "type",
+				Description: "The type of the resource.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "provisioning_state",
+				Description: "The provisioning state of the product.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties").TransformP(extractGenericResourceProperty, "provisioningState"),
+			},
+			{
+				Name:        "description",
+				Description: "The description of the product.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties").TransformP(extractGenericResourceProperty, "description"),
+			},
+			{
+				Name:        "system_data",
+				Description: "The metadata relating to the creation and last modification of the product.",
+				Type:        proto.ColumnType_JSON,
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+			{
+				Name:        "akas",
+				Description: ColumnDescriptionAkas,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("ID").Transform(idToAkas),
+			},
+
+			// Azure standard columns
+			{
+				Name:        "region",
+				Description: ColumnDescriptionRegion,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Location").Transform(toLower),
+			},
+			{
+				Name:        "resource_group",
+				Description: ColumnDescriptionResourceGroup,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ID").Transform(extractResourceGroupFromID),
+			},
+		}),
+	}
+}
+
+type SphereProductInfo = struct {
+	sphereResource
+	CatalogName *string
+}
+
+//// LIST FUNCTION
+
+func listSphereProducts(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	catalog := h.Item.(sphereResource)
+
+	session, err := GetNewSessionUpdated(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_sphere_product.listSphereProducts", "session_error", err)
+		return nil, err
+	}
+
+	client, err := arm.NewClient("azure_sphere_product", "v1.0.0", session.Cred, session.ClientOptions)
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_sphere_product.listSphereProducts", "client_error", err)
+		return nil, err
+	}
+
+	products, err := listSphereResources(ctx, client, *catalog.ID+"/products")
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_sphere_product.listSphereProducts", "api_error", err)
+		return nil, err
+	}
+
+	for _, product := range products {
+		// Products are proxy resources, so the location is taken from the catalog
+		product.Location = catalog.Location
+		d.StreamListItem(ctx, SphereProductInfo{product, catalog.Name})
+		// Check if context has been cancelled or if the limit has been hit (if specified)
+		// if there is a limit, it will return the number of rows required to reach this limit
+		if d.RowsRemaining(ctx) == 0 {
+			return nil, nil
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getSphereProduct(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	catalogName := d.EqualsQualString("catalog_name")
+	name := d.EqualsQualString("name")
+	resourceGroup := d.EqualsQualString("resource_group")
+
+	// Handle empty check
+	if catalogName == "" || name == "" || resourceGroup == "" {
+		return nil, nil
+	}
+
+	session, err := GetNewSessionUpdated(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_sphere_product.getSphereProduct", "session_error", err)
+		return nil, err
+	}
+
+	client, err := arm.NewClient("azure_sphere_product", "v1.0.0", session.Cred, session.ClientOptions)
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_sphere_product.getSphereProduct", "client_error", err)
+		return nil, err
+	}
+
+	catalogPath := fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.AzureSphere/catalogs/%s", session.SubscriptionID, resourceGroup, catalogName)
+	product, err := getSphereResource(ctx, client, catalogPath+"/products/"+name)
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_sphere_product.getSphereProduct", "api_error", err)
+		return nil, err
+	}
+
+	// In some cases resource does not give any notFound error
+	// instead of notFound error, it returns empty data
+	if product.ID == nil {
+		return nil, nil
+	}
+
+	// Products are proxy resources, so the location is taken from the catalog
+	catalog, err := getSphereResource(ctx, client, catalogPath)
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_sphere_product.getSphereProduct", "api_error", err)
+		return nil, err
+	}
+	product.Location = catalog.Location
+
+	return SphereProductInfo{*product, catalog.Name}, nil
+}
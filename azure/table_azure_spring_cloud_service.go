@@ -29,6 +29,13 @@ func tableAzureSpringCloudService(_ context.Context) *plugin.Table {
 			ParentHydrate: listResourceGroups,
 			Hydrate:       listSpringCloudServices,
 		},
+		HydrateConfig: []plugin.HydrateConfig{
+			{
+				Func:           listSpringCloudServiceDiagnosticSettings,
+				MaxConcurrency: diagnosticSettingsMaxConcurrency,
+				Tags:           map[string]string{"service": "Microsoft.Insights", "action": "diagnosticSettings/list"},
+			},
+		},
 		Columns: azureColumns([]*plugin.Column{
 			{
 				Name:        "name",
@@ -160,6 +160,24 @@ func tableAzureSqlDatabase(_ context.Context) *plugin.Table {
 				Type:        proto.ColumnType_STRING,
 				Transform:   transform.FromField("Properties.RequestedServiceObjectiveName"),
 			},
+			{
+				Name:        "service_objective_name",
+				Description: "The name of the current service level objective of the database.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties.CurrentServiceObjectiveName"),
+			},
+			{
+				Name:        "catalog_collation",
+				Description: "The collation of the metadata catalog. Possible values include: 'DATABASE_DEFAULT', 'SQL_Latin1_General_CP1_CI_AS'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties.CatalogCollation"),
+			},
+			{
+				Name:        "license_type",
+				Description: "The license type to apply for this database. Possible values include: 'LicenseIncluded', 'BasePrice'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties.LicenseType"),
+			},
 			{
 				Name:        "retention_policy_id",
 				Description: "Retention policy ID.",
@@ -270,6 +288,13 @@ func tableAzureSqlDatabase(_ context.Context) *plugin.Table {
 				Hydrate:     getSqlDatabaseBlobAuditingPolicies,
 				Transform:   transform.FromValue(),
 			},
+			{
+				Name:        "threat_detection_policy",
+				Description: "The threat detection policy of the database, which defines the alerts raised for anomalous activities.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getSqlDatabaseThreatDetectionPolicy,
+				Transform:   transform.FromField("Properties"),
+			},
 
 			// Steampipe standard columns
 			{
@@ -489,6 +514,33 @@ func getSqlDatabaseBlobAuditingPolicies(ctx context.Context, d *plugin.QueryData
 	return blobPolicies, nil
 }
 
+func getSqlDatabaseThreatDetectionPolicy(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	database := h.Item.(armsql.Database)
+	serverName := strings.Split(*database.ID, "/")[8]
+	resourceGroupName := strings.Split(string(*database.ID), "/")[4]
+	databaseName := *database.Name
+
+	session, err := GetNewSessionUpdated(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_sql_database.getSqlDatabaseThreatDetectionPolicy", "session_error", err)
+		return nil, err
+	}
+	client, err := armsql.NewDatabaseSecurityAlertPoliciesClient(session.SubscriptionID, session.Cred, session.ClientOptions)
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_sql_database.getSqlDatabaseThreatDetectionPolicy", "client_error", err)
+		return nil, err
+	}
+
+	// Threat detection policies are exposed as security alert policies in the current API versions
+	op, err := client.Get(ctx, resourceGroupName, serverName, databaseName, armsql.SecurityAlertPolicyNameDefault, nil)
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_sql_database.getSqlDatabaseThreatDetectionPolicy", "api_error", err)
+		return nil, err
+	}
+
+	return op.DatabaseSecurityAlertPolicy, nil
+}
+
 func listSqlDatabaseVulnerabilityAssessments(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
 	database := h.Item.(armsql.Database)
 	serverName := strings.Split(*database.ID, "/")[8]
@@ -0,0 +1,221 @@
+package azure
+
+import (
+	"context"
+	"strings"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/sql/armsql"
+)
+
+type SQLDatabaseGeoBackupPolicyInfo = struct {
+	armsql.GeoBackupPolicy
+	DatabaseName  *string
+	ServerName    *string
+	ResourceGroup *string
+}
+
+//// TABLE DEFINITION
+
+func tableAzureSQLDatabaseGeoBackupPolicy(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "azure_sql_database_geo_backup_policy",
+		Description: "Azure SQL Database Geo Backup Policy",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.AllColumns([]string{"server_name", "database_name", "resource_group"}),
+			Hydrate:    getSQLDatabaseGeoBackupPolicy,
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: isNotFoundError([]string{"ResourceNotFound", "ResourceGroupNotFound"}),
+			},
+		},
+		List: &plugin.ListConfig{
+			ParentHydrate: listSQLServer,
+			Hydrate:       listSQLDatabaseGeoBackupPolicies,
+		},
+		Columns: azureColumns([]*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name of the geo backup policy.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "id",
+				Description: "The ID of the geo backup policy.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromGo(),
+			},
+			{
+				Name:        "type",
+				Description: "The type of the resource.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "kind",
+				Description: "The kind of the geo backup policy. This is metadata used for the Azure portal experience.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "state",
+				Description: "The state of the geo backup policy. Possible values include: 'Enabled', 'Disabled'. Null if the edition of the database does not support geo backups.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties.State"),
+			},
+			{
+				Name:        "storage_type",
+				Description: "The storage type of the geo backup policy.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties.StorageType"),
+			},
+			{
+				Name:        "database_name",
+				Description: "The name of the database the geo backup policy belongs to.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "server_name",
+				Description: "The name of the server the database belongs to.",
+				Type:        proto.ColumnType_STRING,
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+			{
+				Name:        "akas",
+				Description: ColumnDescriptionAkas,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("ID").Transform(idToAkas),
+			},
+
+			// Azure standard columns
+			{
+				Name:        "region",
+				Description: ColumnDescriptionRegion,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Location").Transform(toLower),
+			},
+			{
+				Name:        "resource_group",
+				Description: ColumnDescriptionResourceGroup,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ResourceGroup").Transform(toLower),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listSQLDatabaseGeoBackupPolicies(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	server := h.Item.(armsql.Server)
+	resourceGroupName := strings.Split(string(*server.ID), "/")[4]
+
+	session, err := GetNewSessionUpdated(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_sql_database_geo_backup_policy.listSQLDatabaseGeoBackupPolicies", "session_error", err)
+		return nil, err
+	}
+	databaseClient, err := armsql.NewDatabasesClient(session.SubscriptionID, session.Cred, session.ClientOptions)
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_sql_database_geo_backup_policy.listSQLDatabaseGeoBackupPolicies", "client_error", err)
+		return nil, err
+	}
+	client, err := armsql.NewGeoBackupPoliciesClient(session.SubscriptionID, session.Cred, session.ClientOptions)
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_sql_database_geo_backup_policy.listSQLDatabaseGeoBackupPolicies", "client_error", err)
+		return nil, err
+	}
+
+	pager := databaseClient.NewListByServerPager(resourceGroupName, *server.Name, nil)
+	for pager.More() {
+		result, err := pager.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("azure_sql_database_geo_backup_policy.listSQLDatabaseGeoBackupPolicies", "api_error", err)
+			return nil, err
+		}
+		for _, database := range result.Value {
+			// The master database does not have a geo backup policy
+			if *database.Name == "master" {
+				continue
+			}
+
+			policy, err := getSQLDatabaseGeoBackupPolicyInfo(ctx, client, resourceGroupName, *server.Name, *database.Name)
+			if err != nil {
+				plugin.Logger(ctx).Error("azure_sql_database_geo_backup_policy.listSQLDatabaseGeoBackupPolicies", "api_error", err)
+				return nil, err
+			}
+			d.StreamListItem(ctx, policy)
+
+			// Check if context has been cancelled or if the limit has been hit (if specified)
+			// if there is a limit, it will return the number of rows required to reach this limit
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getSQLDatabaseGeoBackupPolicy(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("getSQLDatabaseGeoBackupPolicy")
+
+	serverName := d.EqualsQualString("server_name")
+	databaseName := d.EqualsQualString("database_name")
+	resourceGroupName := d.EqualsQualString("resource_group")
+
+	// check if server_name, database_name or resource_group is nil
+	if serverName == "" || databaseName == "" || resourceGroupName == "" || databaseName == "master" {
+		return nil, nil
+	}
+
+	session, err := GetNewSessionUpdated(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_sql_database_geo_backup_policy.getSQLDatabaseGeoBackupPolicy", "session_error", err)
+		return nil, err
+	}
+	client, err := armsql.NewGeoBackupPoliciesClient(session.SubscriptionID, session.Cred, session.ClientOptions)
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_sql_database_geo_backup_policy.getSQLDatabaseGeoBackupPolicy", "client_error", err)
+		return nil, err
+	}
+
+	policy, err := getSQLDatabaseGeoBackupPolicyInfo(ctx, client, resourceGroupName, serverName, databaseName)
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_sql_database_geo_backup_policy.getSQLDatabaseGeoBackupPolicy", "api_error", err)
+		return nil, err
+	}
+
+	return policy, nil
+}
+
+// getSQLDatabaseGeoBackupPolicyInfo returns the geo backup policy of a database. The API returns
+// a 404 error for database editions that do not support geo backups, in which case a row with
+// an empty policy is returned so that the database is still listed.
+func getSQLDatabaseGeoBackupPolicyInfo(ctx context.Context, client *armsql.GeoBackupPoliciesClient, resourceGroupName string, serverName string, databaseName string) (SQLDatabaseGeoBackupPolicyInfo, error) {
+	policy := SQLDatabaseGeoBackupPolicyInfo{
+		DatabaseName:  &databaseName,
+		ServerName:    &serverName,
+		ResourceGroup: &resourceGroupName,
+	}
+
+	op, err := client.Get(ctx, resourceGroupName, serverName, databaseName, armsql.GeoBackupPolicyNameDefault, nil)
+	if err != nil {
+		if strings.Contains(err.Error(), "404") {
+			return policy, nil
+		}
+		return policy, err
+	}
+	policy.GeoBackupPolicy = op.GeoBackupPolicy
+
+	return policy, nil
+}
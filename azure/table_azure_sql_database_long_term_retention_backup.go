@@ -0,0 +1,207 @@
+package azure
+
+import (
+	"context"
+
+	"github.com/Azure/azure-sdk-for-go/profiles/latest/resources/mgmt/resources"
+	sub "github.com/Azure/azure-sdk-for-go/profiles/latest/subscription/mgmt/subscription"
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/sql/armsql"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+)
+
+// The SQL API version of the Azure SDK used by the plugin does not return
+// whether a backup is immutable, so it is read as a generic resource
+const sqlDatabaseLongTermRetentionBackupAPIVersion = "2023-08-01-preview"
+
+//// TABLE DEFINITION
+
+func tableAzureSQLDatabaseLongTermRetentionBackup(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "azure_sql_database_long_term_retention_backup",
+		Description: "Azure SQL Database Long Term Retention Backup",
+		List: &plugin.ListConfig{
+			ParentHydrate: listLocations,
+			Hydrate:       listSQLDatabaseLongTermRetentionBackups,
+			KeyColumns: []*plugin.KeyColumn{
+				{
+					Name:    "location",
+					Require: plugin.Optional,
+				},
+			},
+		},
+		Columns: azureColumns([]*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name of the long term retention backup.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Backup.Name"),
+			},
+			{
+				Name:        "id",
+				Description: "The ID of the long term retention backup.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Backup.ID"),
+			},
+			{
+				Name:        "type",
+				Description: "The type of the resource.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Backup.Type"),
+			},
+			{
+				Name:        "server_name",
+				Description: "The server name that the backup database belong to.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Backup.Properties.ServerName"),
+			},
+			{
+				Name:        "server_create_time",
+				Description: "The create time of the server.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("Backup.Properties.ServerCreateTime"),
+			},
+			{
+				Name:        "database_name",
+				Description: "The name of the database the backup belong to.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Backup.Properties.DatabaseName"),
+			},
+			{
+				Name:        "database_deletion_time",
+				Description: "The delete time of the database.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("Backup.Properties.DatabaseDeletionTime"),
+			},
+			{
+				Name:        "backup_time",
+				Description: "The time the backup was taken.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("Backup.Properties.BackupTime"),
+			},
+			{
+				Name:        "backup_expiry_time",
+				Description: "The time the long term retention backup will expire.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("Backup.Properties.BackupExpirationTime"),
+			},
+			{
+				Name:        "backup_storage_redundancy",
+				Description: "The storage redundancy type of the backup. Possible values include: 'Geo', 'Local', 'Zone', 'GeoZone'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Backup.Properties.BackupStorageRedundancy"),
+			},
+			{
+				Name:        "requested_backup_storage_redundancy",
+				Description: "The storage redundancy type of the backup requested by the user. Possible values include: 'Geo', 'Local', 'Zone', 'GeoZone'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Backup.Properties.RequestedBackupStorageRedundancy"),
+			},
+			{
+				Name:        "is_backup_immutable",
+				Description: "Indicates whether the backup is immutable.",
+				Type:        proto.ColumnType_BOOL,
+				Hydrate:     getSQLDatabaseLongTermRetentionBackupProperties,
+				Transform:   transform.FromField("Properties").TransformP(extractGenericResourceProperty, "isBackupImmutable"),
+			},
+			{
+				Name:        "location",
+				Description: "The location of the long term retention backup.",
+				Type:        proto.ColumnType_STRING,
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Backup.Name"),
+			},
+			{
+				Name:        "akas",
+				Description: ColumnDescriptionAkas,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Backup.ID").Transform(idToAkas),
+			},
+
+			// Azure standard columns
+			{
+				Name:        "resource_group",
+				Description: "The resource group of the server the backup belongs to. Backups are listed by location and their IDs do not contain a resource group, so this is always null.",
+				Type:        proto.ColumnType_STRING,
+			},
+		}),
+	}
+}
+
+type SQLDatabaseLongTermRetentionBackupInfo struct {
+	Backup   armsql.LongTermRetentionBackup
+	Location *string
+}
+
+//// LIST FUNCTION
+
+func listSQLDatabaseLongTermRetentionBackups(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	location := h.Item.(sub.Location)
+
+	// Restrict the API call for other locations if the location is specified in the query parameter
+	if d.EqualsQualString("location") != "" && d.EqualsQualString("location") != *location.Name {
+		return nil, nil
+	}
+
+	session, err := GetNewSessionUpdated(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_sql_database_long_term_retention_backup.listSQLDatabaseLongTermRetentionBackups", "session_error", err)
+		return nil, err
+	}
+	client, err := armsql.NewLongTermRetentionBackupsClient(session.SubscriptionID, session.Cred, session.ClientOptions)
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_sql_database_long_term_retention_backup.listSQLDatabaseLongTermRetentionBackups", "client_error", err)
+		return nil, err
+	}
+
+	pager := client.NewListByLocationPager(*location.Name, nil)
+	for pager.More() {
+		result, err := pager.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("azure_sql_database_long_term_retention_backup.listSQLDatabaseLongTermRetentionBackups", "api_error", err)
+			return nil, err
+		}
+		for _, backup := range result.Value {
+			d.StreamListItem(ctx, SQLDatabaseLongTermRetentionBackupInfo{*backup, location.Name})
+
+			// Check if context has been cancelled or if the limit has been hit (if specified)
+			// if there is a limit, it will return the number of rows required to reach this limit
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getSQLDatabaseLongTermRetentionBackupProperties(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	backup := h.Item.(SQLDatabaseLongTermRetentionBackupInfo)
+
+	session, err := GetNewSession(ctx, d, "MANAGEMENT")
+	if err != nil {
+		return nil, err
+	}
+	subscriptionID := session.SubscriptionID
+
+	client := resources.NewClientWithBaseURI(session.ResourceManagerEndpoint, subscriptionID)
+	client.Authorizer = session.Authorizer
+
+	op, err := client.GetByID(ctx, *backup.Backup.ID, sqlDatabaseLongTermRetentionBackupAPIVersion)
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_sql_database_long_term_retention_backup.getSQLDatabaseLongTermRetentionBackupProperties", "api_error", err)
+		return nil, err
+	}
+
+	return op, nil
+}
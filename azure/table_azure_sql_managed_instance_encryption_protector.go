@@ -0,0 +1,215 @@
+package azure
+
+import (
+	"context"
+	"strings"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/sql/armsql"
+)
+
+type ManagedInstanceEncryptionProtectorInfo = struct {
+	armsql.ManagedInstanceEncryptionProtector
+	ManagedInstanceName *string
+	Location            *string
+}
+
+//// TABLE DEFINITION
+
+func tableAzureSQLManagedInstanceEncryptionProtector(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "azure_sql_managed_instance_encryption_protector",
+		Description: "Azure SQL Managed Instance Encryption Protector",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.AllColumns([]string{"managed_instance_name", "resource_group"}),
+			Hydrate:    getSQLManagedInstanceEncryptionProtector,
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: isNotFoundError([]string{"ResourceNotFound", "ResourceGroupNotFound", "404"}),
+			},
+		},
+		List: &plugin.ListConfig{
+			Hydrate:       listSQLManagedInstanceEncryptionProtectors,
+			ParentHydrate: listMSSQLManagedInstances,
+		},
+		Columns: azureColumns([]*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name of the encryption protector. It is always 'current'.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "id",
+				Description: "The ID of the encryption protector.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromGo(),
+			},
+			{
+				Name:        "managed_instance_name",
+				Description: "The name of the managed instance the encryption protector belongs to.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "type",
+				Description: "The type of the resource.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "kind",
+				Description: "The kind of encryption protector, e.g. servicemanaged or azurekeyvault.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "server_key_type",
+				Description: "The encryption protector type, i.e. whether the TDE protector is service-managed or a customer-managed key in Azure Key Vault. Possible values include: 'ServiceManaged', 'AzureKeyVault'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties.ServerKeyType"),
+			},
+			{
+				Name:        "server_key_name",
+				Description: "The name of the managed instance key.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties.ServerKeyName"),
+			},
+			{
+				Name:        "uri",
+				Description: "The URI of the server key.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties.URI"),
+			},
+			{
+				Name:        "thumbprint",
+				Description: "The thumbprint of the server key.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties.Thumbprint"),
+			},
+			{
+				Name:        "auto_rotation_enabled",
+				Description: "Indicates whether key auto rotation is enabled.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("Properties.AutoRotationEnabled"),
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+			{
+				Name:        "akas",
+				Description: ColumnDescriptionAkas,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("ID").Transform(idToAkas),
+			},
+
+			// Azure standard columns
+			{
+				Name:        "region",
+				Description: ColumnDescriptionRegion,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Location").Transform(toLower),
+			},
+			{
+				Name:        "resource_group",
+				Description: ColumnDescriptionResourceGroup,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ID").Transform(extractResourceGroupFromID),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listSQLManagedInstanceEncryptionProtectors(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	managedInstance := h.Item.(armsql.ManagedInstance)
+	resourceGroup := strings.Split(*managedInstance.ID, "/")[4]
+
+	session, err := GetNewSessionUpdated(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_sql_managed_instance_encryption_protector.listSQLManagedInstanceEncryptionProtectors", "session_error", err)
+		return nil, err
+	}
+
+	client, err := armsql.NewManagedInstanceEncryptionProtectorsClient(session.SubscriptionID, session.Cred, session.ClientOptions)
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_sql_managed_instance_encryption_protector.listSQLManagedInstanceEncryptionProtectors", "client_error", err)
+		return nil, err
+	}
+
+	pager := client.NewListByInstancePager(resourceGroup, *managedInstance.Name, nil)
+	for pager.More() {
+		result, err := pager.NextPage(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("azure_sql_managed_instance_encryption_protector.listSQLManagedInstanceEncryptionProtectors", "api_error", err)
+			return nil, err
+		}
+		for _, protector := range result.Value {
+			d.StreamListItem(ctx, ManagedInstanceEncryptionProtectorInfo{*protector, managedInstance.Name, managedInstance.Location})
+			// Check if context has been cancelled or if the limit has been hit (if specified)
+			// if there is a limit, it will return the number of rows required to reach this limit
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getSQLManagedInstanceEncryptionProtector(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("getSQLManagedInstanceEncryptionProtector")
+
+	managedInstanceName := d.EqualsQualString("managed_instance_name")
+	resourceGroup := d.EqualsQualString("resource_group")
+
+	// Return nil, of no input provided
+	if managedInstanceName == "" || resourceGroup == "" {
+		return nil, nil
+	}
+
+	session, err := GetNewSessionUpdated(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_sql_managed_instance_encryption_protector.getSQLManagedInstanceEncryptionProtector", "session_error", err)
+		return nil, err
+	}
+
+	client, err := armsql.NewManagedInstanceEncryptionProtectorsClient(session.SubscriptionID, session.Cred, session.ClientOptions)
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_sql_managed_instance_encryption_protector.getSQLManagedInstanceEncryptionProtector", "client_error", err)
+		return nil, err
+	}
+
+	op, err := client.Get(ctx, resourceGroup, managedInstanceName, armsql.EncryptionProtectorNameCurrent, nil)
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_sql_managed_instance_encryption_protector.getSQLManagedInstanceEncryptionProtector", "api_error", err)
+		return nil, err
+	}
+
+	// In some cases resource does not give any notFound error
+	// instead of notFound error, it returns empty data
+	if op.ID == nil {
+		return nil, nil
+	}
+
+	// The encryption protector does not return the location, so it is taken from the managed instance
+	instanceClient, err := armsql.NewManagedInstancesClient(session.SubscriptionID, session.Cred, session.ClientOptions)
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_sql_managed_instance_encryption_protector.getSQLManagedInstanceEncryptionProtector", "client_error", err)
+		return nil, err
+	}
+
+	instance, err := instanceClient.Get(ctx, resourceGroup, managedInstanceName, nil)
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_sql_managed_instance_encryption_protector.getSQLManagedInstanceEncryptionProtector", "api_error", err)
+		return nil, err
+	}
+
+	return ManagedInstanceEncryptionProtectorInfo{op.ManagedInstanceEncryptionProtector, &managedInstanceName, instance.Location}, nil
+}
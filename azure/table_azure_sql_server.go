@@ -72,6 +72,12 @@ func tableAzureSQLServer(_ context.Context) *plugin.Table {
 				Type:        proto.ColumnType_STRING,
 				Transform:   transform.FromField("Properties.AdministratorLoginPassword"),
 			},
+			{
+				Name:        "administrators",
+				Description: "The Azure Active Directory identity of the server, with the login, SID and tenant of the administrator and whether only Azure AD authentication is allowed.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Properties.Administrators"),
+			},
 			{
 				Name:        "minimal_tls_version",
 				Description: "Minimal TLS version. Allowed values: '1.0', '1.1', '1.2'.",
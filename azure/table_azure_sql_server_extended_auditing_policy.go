@@ -0,0 +1,229 @@
+package azure
+
+import (
+	"context"
+	"strings"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/sql/armsql"
+)
+
+//// TABLE DEFINITION
+
+func tableAzureSQLServerExtendedAuditingPolicy(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "azure_sql_server_extended_auditing_policy",
+		Description: "Azure SQL Server Extended Auditing Policy",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.AllColumns([]string{"server_name", "resource_group"}),
+			Hydrate:    getSQLServerExtendedAuditingPolicy,
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: isNotFoundError([]string{"ResourceNotFound", "ResourceGroupNotFound", "404"}),
+			},
+		},
+		List: &plugin.ListConfig{
+			ParentHydrate: listSQLServer,
+			Hydrate:       listSQLServerExtendedAuditingPolicies,
+		},
+		Columns: azureColumns([]*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name of the extended auditing policy.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "id",
+				Description: "The ID of the extended auditing policy.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromGo(),
+			},
+			{
+				Name:        "type",
+				Description: "The type of the resource.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "server_name",
+				Description: "The name of the server the auditing policy belongs to.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ID").Transform(extractSQLServerNameFromID),
+			},
+			{
+				Name:        "state",
+				Description: "The state of the auditing policy. Possible values include: 'Enabled', 'Disabled'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties.State"),
+			},
+			{
+				Name:        "storage_endpoint",
+				Description: "The blob storage endpoint that holds the audit logs, e.g. https://MyAccount.blob.core.windows.net.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties.StorageEndpoint"),
+			},
+			{
+				Name:        "storage_account_subscription_id",
+				Description: "The subscription ID of the storage account that holds the audit logs.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties.StorageAccountSubscriptionID"),
+			},
+			{
+				Name:        "retention_days",
+				Description: "The number of days to keep the audit logs in the storage account.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("Properties.RetentionDays"),
+			},
+			{
+				Name:        "is_storage_secondary_key_in_use",
+				Description: "Indicates whether the storage account secondary key is used to write the audit logs.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("Properties.IsStorageSecondaryKeyInUse"),
+			},
+			{
+				Name:        "is_azure_monitor_target_enabled",
+				Description: "Indicates whether audit events are sent to Azure Monitor, and from there to the Log Analytics workspace or event hub configured in the server diagnostic settings.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("Properties.IsAzureMonitorTargetEnabled"),
+			},
+			{
+				Name:        "is_devops_audit_enabled",
+				Description: "Indicates whether Microsoft support operations are audited.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("Properties.IsDevopsAuditEnabled"),
+			},
+			{
+				Name:        "is_managed_identity_in_use",
+				Description: "Indicates whether a managed identity is used to access the blob storage.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("Properties.IsManagedIdentityInUse"),
+			},
+			{
+				Name:        "queue_delay_ms",
+				Description: "The time in milliseconds that can elapse before audit actions are forced to be processed.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("Properties.QueueDelayMs"),
+			},
+			{
+				Name:        "predicate_expression",
+				Description: "The where clause used to filter the audited events.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties.PredicateExpression"),
+			},
+			{
+				Name:        "audit_actions_and_groups",
+				Description: "The actions and groups of actions to audit.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Properties.AuditActionsAndGroups"),
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+			{
+				Name:        "akas",
+				Description: ColumnDescriptionAkas,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("ID").Transform(idToAkas),
+			},
+
+			// Azure standard columns
+			{
+				Name:        "resource_group",
+				Description: ColumnDescriptionResourceGroup,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ID").Transform(extractResourceGroupFromID),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listSQLServerExtendedAuditingPolicies(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	server := h.Item.(armsql.Server)
+	resourceGroupName := strings.Split(string(*server.ID), "/")[4]
+
+	session, err := GetNewSessionUpdated(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_sql_server_extended_auditing_policy.listSQLServerExtendedAuditingPolicies", "session_error", err)
+		return nil, err
+	}
+	client, err := armsql.NewExtendedServerBlobAuditingPoliciesClient(session.SubscriptionID, session.Cred, session.ClientOptions)
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_sql_server_extended_auditing_policy.listSQLServerExtendedAuditingPolicies", "client_error", err)
+		return nil, err
+	}
+
+	// A server has a single extended auditing policy, named Default
+	op, err := client.Get(ctx, resourceGroupName, *server.Name, nil)
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_sql_server_extended_auditing_policy.listSQLServerExtendedAuditingPolicies", "api_error", err)
+		return nil, err
+	}
+
+	if op.ID != nil {
+		d.StreamListItem(ctx, op.ExtendedServerBlobAuditingPolicy)
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func getSQLServerExtendedAuditingPolicy(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("getSQLServerExtendedAuditingPolicy")
+
+	serverName := d.EqualsQualString("server_name")
+	resourceGroupName := d.EqualsQualString("resource_group")
+
+	// check if server_name or resource_group is nil
+	if serverName == "" || resourceGroupName == "" {
+		return nil, nil
+	}
+
+	session, err := GetNewSessionUpdated(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_sql_server_extended_auditing_policy.getSQLServerExtendedAuditingPolicy", "session_error", err)
+		return nil, err
+	}
+	client, err := armsql.NewExtendedServerBlobAuditingPoliciesClient(session.SubscriptionID, session.Cred, session.ClientOptions)
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_sql_server_extended_auditing_policy.getSQLServerExtendedAuditingPolicy", "client_error", err)
+		return nil, err
+	}
+
+	op, err := client.Get(ctx, resourceGroupName, serverName, nil)
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_sql_server_extended_auditing_policy.getSQLServerExtendedAuditingPolicy", "api_error", err)
+		return nil, err
+	}
+
+	// In some cases resource does not give any notFound error
+	// instead of notFound error, it returns empty data
+	if op.ID != nil {
+		return op.ExtendedServerBlobAuditingPolicy, nil
+	}
+
+	return nil, nil
+}
+
+//// TRANSFORM FUNCTIONS
+
+// extractSQLServerNameFromID returns the server name from the ID of a server child resource
+func extractSQLServerNameFromID(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	id, ok := d.Value.(*string)
+	if !ok || id == nil {
+		return nil, nil
+	}
+	parts := strings.Split(*id, "/")
+	if len(parts) < 9 {
+		return nil, nil
+	}
+	return parts[8], nil
+}
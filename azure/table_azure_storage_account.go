@@ -39,6 +39,13 @@ func tableAzureStorageAccount(_ context.Context) *plugin.Table {
 		List: &plugin.ListConfig{
 			Hydrate: listStorageAccounts,
 		},
+		HydrateConfig: []plugin.HydrateConfig{
+			{
+				Func:           listStorageAccountDiagnosticSettings,
+				MaxConcurrency: diagnosticSettingsMaxConcurrency,
+				Tags:           map[string]string{"service": "Microsoft.Insights", "action": "diagnosticSettings/list"},
+			},
+		},
 		Columns: azureColumns([]*plugin.Column{
 			{
 				Name:        "name",
@@ -191,6 +198,12 @@ func tableAzureStorageAccount(_ context.Context) *plugin.Table {
 				Type:        proto.ColumnType_TIMESTAMP,
 				Transform:   transform.FromField("Account.AccountProperties.Encryption.KeyVaultProperties.LastKeyRotationTimestamp").Transform(convertDateToTime),
 			},
+			{
+				Name:        "enable_nfs_v3",
+				Description: "Specifies whether NFS 3.0 protocol support is enabled.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("Account.AccountProperties.EnableNfsV3"),
+			},
 			{
 				Name:        "failover_in_progress",
 				Description: "Specifies whether the failover is in progress.",
@@ -287,6 +300,12 @@ func tableAzureStorageAccount(_ context.Context) *plugin.Table {
 				Hydrate:     getAzureStorageAccountTableProperties,
 				Transform:   transform.FromField("Logging.Version"),
 			},
+			{
+				Name:        "large_file_shares_state",
+				Description: "Specifies whether large file shares are allowed. Possible values include: 'Disabled', 'Enabled'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Account.AccountProperties.LargeFileSharesState").Transform(transform.ToString),
+			},
 			{
 				Name:        "minimum_tls_version",
 				Description: "Contains the minimum TLS version to be permitted on requests to storage.",
@@ -377,6 +396,13 @@ func tableAzureStorageAccount(_ context.Context) *plugin.Table {
 				Type:        proto.ColumnType_STRING,
 				Transform:   transform.FromField("Account.AccountProperties.StatusOfSecondary"),
 			},
+			{
+				Name:        "blob_service_properties",
+				Description: "The properties of the Blob service of the storage account, such as the delete retention policies, versioning, change feed and CORS rules.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getAzureStorageAccountBlobProperties,
+				Transform:   transform.FromField("BlobServicePropertiesProperties"),
+			},
 			{
 				Name:        "blob_service_logging",
 				Description: "Specifies the blob service properties for logging access.",
@@ -411,6 +437,13 @@ func tableAzureStorageAccount(_ context.Context) *plugin.Table {
 				Type:        proto.ColumnType_JSON,
 				Transform:   transform.FromField("Account.AccountProperties.Encryption.Services"),
 			},
+			{
+				Name:        "file_service_properties",
+				Description: "The properties of the File service of the storage account, such as the share delete retention policy, protocol settings and CORS rules.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getAzureStorageAccountFileProperties,
+				Transform:   transform.FromValue(),
+			},
 			{
 				Name:        "lifecycle_management_policy",
 				Description: "The managementpolicy associated with the specified storage account.",
@@ -424,12 +457,25 @@ func tableAzureStorageAccount(_ context.Context) *plugin.Table {
 				Type:        proto.ColumnType_JSON,
 				Transform:   transform.FromField("Account.AccountProperties.NetworkRuleSet.IPRules"),
 			},
+			{
+				Name:        "network_rule_set",
+				Description: "The network rule set of the storage account, with the default action, the bypassed services and the IP, virtual network and resource access rules.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Account.AccountProperties.NetworkRuleSet"),
+			},
 			{
 				Name:        "private_endpoint_connections",
 				Description: "A list of private endpoint connection associated with the specified storage account.",
 				Type:        proto.ColumnType_JSON,
 				Transform:   transform.FromField("Account.AccountProperties.PrivateEndpointConnections"),
 			},
+			{
+				Name:        "queue_service_properties",
+				Description: "The properties of the Queue service of the storage account, such as the logging, metrics and CORS settings.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getAzureStorageAccountQueueProperties,
+				Transform:   transform.FromValue(),
+			},
 			{
 				Name:        "table_properties",
 				Description: "Azure Analytics Logging settings of tables.",
@@ -0,0 +1,201 @@
+package azure
+
+import (
+	"context"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/profiles/latest/storage/mgmt/storage"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+)
+
+type storageAccountCorsRuleInfo = struct {
+	storage.CorsRule
+	ServiceType        string
+	RuleIndex          int
+	StorageAccountName *string
+	ResourceGroup      *string
+	Location           *string
+}
+
+//// TABLE DEFINITION ////
+
+func tableAzureStorageAccountCorsRule(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "azure_storage_account_cors_rule",
+		Description: "Azure Storage Account CORS Rule",
+		List: &plugin.ListConfig{
+			ParentHydrate: listStorageAccounts,
+			Hydrate:       listStorageAccountCorsRules,
+		},
+		Columns: azureColumns([]*plugin.Column{
+			{
+				Name:        "storage_account_name",
+				Description: "The name of the storage account the CORS rule belongs to.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "service_type",
+				Description: "The storage service the CORS rule applies to. Possible values are: 'Blob', 'File', 'Queue', 'Table'.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "rule_index",
+				Description: "The position of the rule in the CORS rules of the service, starting at 0.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "has_wildcard_origin",
+				Description: "True if the rule allows requests from all origins ('*').",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("AllowedOrigins").Transform(hasStorageCorsWildcardOrigin),
+			},
+			{
+				Name:        "max_age_in_seconds",
+				Description: "The number of seconds that the client/browser should cache a preflight response.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "allowed_origins",
+				Description: "The origin domains that are permitted to make a request against the storage service via CORS, or '*' to allow all domains.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "allowed_methods",
+				Description: "The HTTP methods that are permitted to be executed by the origin.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "allowed_headers",
+				Description: "The headers allowed to be part of the cross-origin request.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "exposed_headers",
+				Description: "The response headers to expose to CORS clients.",
+				Type:        proto.ColumnType_JSON,
+			},
+
+			// Azure standard columns
+			{
+				Name:        "region",
+				Description: ColumnDescriptionRegion,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Location").Transform(toLower),
+			},
+			{
+				Name:        "resource_group",
+				Description: ColumnDescriptionResourceGroup,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ResourceGroup").Transform(toLower),
+			},
+		}),
+	}
+}
+
+//// FETCH FUNCTIONS ////
+
+func listStorageAccountCorsRules(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	// Get the details of storage account
+	account := h.Item.(*storageAccountInfo)
+	kind := account.Account.Kind
+
+	session, err := GetNewSession(ctx, d, "MANAGEMENT")
+	if err != nil {
+		return nil, err
+	}
+	subscriptionID := session.SubscriptionID
+
+	corsRules := map[string]*storage.CorsRules{}
+
+	// Blob is not supported for the account if storage type is FileStorage
+	if kind != "FileStorage" {
+		client := storage.NewBlobServicesClientWithBaseURI(session.ResourceManagerEndpoint, subscriptionID)
+		client.Authorizer = session.Authorizer
+
+		op, err := client.GetServiceProperties(ctx, *account.ResourceGroup, *account.Name)
+		if err != nil && !strings.Contains(err.Error(), "FeatureNotSupportedForAccount") {
+			plugin.Logger(ctx).Error("azure_storage_account_cors_rule.listStorageAccountCorsRules", "blob_api_error", err)
+			return nil, err
+		}
+		if op.BlobServicePropertiesProperties != nil {
+			corsRules["Blob"] = op.BlobServicePropertiesProperties.Cors
+		}
+	}
+
+	// File is not supported for the account if storage type is BlobStorage
+	if kind != "BlobStorage" {
+		client := storage.NewFileServicesClientWithBaseURI(session.ResourceManagerEndpoint, subscriptionID)
+		client.Authorizer = session.Authorizer
+
+		op, err := client.GetServiceProperties(ctx, *account.ResourceGroup, *account.Name)
+		if err != nil && !strings.Contains(err.Error(), "FeatureNotSupportedForAccount") {
+			plugin.Logger(ctx).Error("azure_storage_account_cors_rule.listStorageAccountCorsRules", "file_api_error", err)
+			return nil, err
+		}
+		if op.FileServicePropertiesProperties != nil {
+			corsRules["File"] = op.FileServicePropertiesProperties.Cors
+		}
+	}
+
+	// Queue and Table are only supported by general-purpose accounts
+	if kind == "Storage" || kind == "StorageV2" {
+		queueClient := storage.NewQueueServicesClientWithBaseURI(session.ResourceManagerEndpoint, subscriptionID)
+		queueClient.Authorizer = session.Authorizer
+
+		queueOp, err := queueClient.GetServiceProperties(ctx, *account.ResourceGroup, *account.Name)
+		if err != nil && !strings.Contains(err.Error(), "FeatureNotSupportedForAccount") {
+			plugin.Logger(ctx).Error("azure_storage_account_cors_rule.listStorageAccountCorsRules", "queue_api_error", err)
+			return nil, err
+		}
+		if queueOp.QueueServicePropertiesProperties != nil {
+			corsRules["Queue"] = queueOp.QueueServicePropertiesProperties.Cors
+		}
+
+		tableClient := storage.NewTableServicesClientWithBaseURI(session.ResourceManagerEndpoint, subscriptionID)
+		tableClient.Authorizer = session.Authorizer
+
+		tableOp, err := tableClient.GetServiceProperties(ctx, *account.ResourceGroup, *account.Name)
+		if err != nil && !strings.Contains(err.Error(), "FeatureNotSupportedForAccount") {
+			plugin.Logger(ctx).Error("azure_storage_account_cors_rule.listStorageAccountCorsRules", "table_api_error", err)
+			return nil, err
+		}
+		if tableOp.TableServicePropertiesProperties != nil {
+			corsRules["Table"] = tableOp.TableServicePropertiesProperties.Cors
+		}
+	}
+
+	for _, serviceType := range []string{"Blob", "File", "Queue", "Table"} {
+		rules := corsRules[serviceType]
+		if rules == nil || rules.CorsRules == nil {
+			continue
+		}
+		for i, rule := range *rules.CorsRules {
+			d.StreamListItem(ctx, storageAccountCorsRuleInfo{rule, serviceType, i, account.Name, account.ResourceGroup, account.Account.Location})
+			// Check if context has been cancelled or if the limit has been hit (if specified)
+			// if there is a limit, it will return the number of rows required to reach this limit
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+//// TRANSFORM FUNCTIONS ////
+
+func hasStorageCorsWildcardOrigin(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	origins, ok := d.Value.(*[]string)
+	if !ok || origins == nil {
+		return false, nil
+	}
+	for _, origin := range *origins {
+		if strings.TrimSpace(origin) == "*" {
+			return true, nil
+		}
+	}
+	return false, nil
+}
@@ -85,6 +85,12 @@ func tableAzureStorageBlobService(_ context.Context) *plugin.Table {
 				Transform:   transform.FromField("Blob.BlobServicePropertiesProperties.ChangeFeed.Enabled"),
 				Default:     false,
 			},
+			{
+				Name:        "change_feed_retention_in_days",
+				Description: "The duration of change feed retention in days. A null value indicates an infinite retention of the change feed",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("Blob.BlobServicePropertiesProperties.ChangeFeed.RetentionInDays"),
+			},
 			{
 				Name:        "default_service_version",
 				Description: "Indicates the default version to use for requests to the Blob service if an incoming request’s version is not specified",
@@ -104,6 +110,19 @@ func tableAzureStorageBlobService(_ context.Context) *plugin.Table {
 				Type:        proto.ColumnType_JSON,
 				Transform:   transform.FromField("Blob.BlobServicePropertiesProperties.ContainerDeleteRetentionPolicy"),
 			},
+			{
+				Name:        "container_soft_delete_enabled",
+				Description: "Specifies whether soft delete is enabled for containers, or not",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("Blob.BlobServicePropertiesProperties.ContainerDeleteRetentionPolicy.Enabled"),
+				Default:     false,
+			},
+			{
+				Name:        "container_soft_delete_retention_days",
+				Description: "The number of days that the deleted containers are retained",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("Blob.BlobServicePropertiesProperties.ContainerDeleteRetentionPolicy.Days"),
+			},
 			{
 				Name:        "cors_rules",
 				Description: "A list of CORS rules for a storage account’s Blob service",
@@ -116,12 +135,31 @@ func tableAzureStorageBlobService(_ context.Context) *plugin.Table {
 				Type:        proto.ColumnType_JSON,
 				Transform:   transform.FromField("Blob.BlobServicePropertiesProperties.DeleteRetentionPolicy"),
 			},
+			{
+				Name:        "blob_soft_delete_enabled",
+				Description: "Specifies whether soft delete is enabled for blobs, or not",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("Blob.BlobServicePropertiesProperties.DeleteRetentionPolicy.Enabled"),
+				Default:     false,
+			},
+			{
+				Name:        "blob_soft_delete_retention_days",
+				Description: "The number of days that the deleted blobs are retained",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("Blob.BlobServicePropertiesProperties.DeleteRetentionPolicy.Days"),
+			},
 			{
 				Name:        "restore_policy",
 				Description: "The blob service properties for blob restore policy",
 				Type:        proto.ColumnType_JSON,
 				Transform:   transform.FromField("Blob.BlobServicePropertiesProperties.RestorePolicy"),
 			},
+			{
+				Name:        "last_access_time_tracking_policy",
+				Description: "The blob service property to configure last access time based tracking policy",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Blob.BlobServicePropertiesProperties.LastAccessTimeTrackingPolicy"),
+			},
 
 			// Steampipe standard columns
 			{
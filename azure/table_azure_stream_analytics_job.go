@@ -27,6 +27,13 @@ func tableAzureStreamAnalyticsJob(_ context.Context) *plugin.Table {
 		List: &plugin.ListConfig{
 			Hydrate: listStreamAnalyticsJobs,
 		},
+		HydrateConfig: []plugin.HydrateConfig{
+			{
+				Func:           listStreamAnalyticsJobDiagnosticSettings,
+				MaxConcurrency: diagnosticSettingsMaxConcurrency,
+				Tags:           map[string]string{"service": "Microsoft.Insights", "action": "diagnosticSettings/list"},
+			},
+		},
 		Columns: azureColumns([]*plugin.Column{
 			{
 				Name:        "name",
@@ -78,6 +78,12 @@ func tableAzureSubnet(_ context.Context) *plugin.Table {
 				Type:        proto.ColumnType_STRING,
 				Transform:   transform.FromField("Subnet.SubnetPropertiesFormat.AddressPrefix"),
 			},
+			{
+				Name:        "address_prefixes",
+				Description: "List of address prefixes for the subnet.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Subnet.SubnetPropertiesFormat.AddressPrefixes"),
+			},
 			{
 				Name:        "nat_gateway_id",
 				Description: "The ID of the Nat gateway associated with the subnet.",
@@ -102,6 +108,12 @@ func tableAzureSubnet(_ context.Context) *plugin.Table {
 				Type:        proto.ColumnType_STRING,
 				Transform:   transform.FromField("Subnet.SubnetPropertiesFormat.PrivateLinkServiceNetworkPolicies"),
 			},
+			{
+				Name:        "purpose",
+				Description: "A read-only string identifying the intention of use for this subnet based on delegations and other user-defined properties.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Subnet.SubnetPropertiesFormat.Purpose"),
+			},
 			{
 				Name:        "route_table_id",
 				Description: "Route table associated with the subnet.",
@@ -114,6 +126,18 @@ func tableAzureSubnet(_ context.Context) *plugin.Table {
 				Type:        proto.ColumnType_JSON,
 				Transform:   transform.FromField("Subnet.SubnetPropertiesFormat.Delegations"),
 			},
+			{
+				Name:        "application_gateway_ip_configurations",
+				Description: "Application gateway IP configurations of virtual network resource.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Subnet.SubnetPropertiesFormat.ApplicationGatewayIPConfigurations"),
+			},
+			{
+				Name:        "ip_allocations",
+				Description: "Array of IpAllocation which reference this subnet.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Subnet.SubnetPropertiesFormat.IPAllocations"),
+			},
 			{
 				Name:        "ip_configurations",
 				Description: "IP Configuration details in a subnet.",
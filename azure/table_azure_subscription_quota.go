@@ -0,0 +1,301 @@
+package azure
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Azure/azure-sdk-for-go/profiles/latest/compute/mgmt/compute"
+	"github.com/Azure/azure-sdk-for-go/profiles/latest/network/mgmt/network"
+	"github.com/Azure/azure-sdk-for-go/profiles/latest/storage/mgmt/storage"
+	sub "github.com/Azure/azure-sdk-for-go/profiles/latest/subscription/mgmt/subscription"
+	"github.com/turbot/go-kit/types"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+)
+
+const (
+	quotaNamespaceCompute = "Microsoft.Compute"
+	quotaNamespaceNetwork = "Microsoft.Network"
+	quotaNamespaceStorage = "Microsoft.Storage"
+)
+
+//// TABLE DEFINITION
+
+func tableAzureSubscriptionQuota(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "azure_subscription_quota",
+		Description: "Azure Subscription Quota",
+		List: &plugin.ListConfig{
+			Hydrate:       listSubscriptionQuotas,
+			ParentHydrate: listLocations,
+			KeyColumns: plugin.KeyColumnSlice{
+				{Name: "namespace", Require: plugin.Optional},
+				{Name: "location", Require: plugin.Optional},
+			},
+		},
+		Columns: azureColumns([]*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name of the quota, e.g. 'cores' or 'VirtualNetworks'.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "id",
+				Description: "The ID of the quota usage.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromGo(),
+			},
+			{
+				Name:        "resource_name",
+				Description: "The localized display name of the quota, e.g. 'Total Regional vCPUs'.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "namespace",
+				Description: "The resource provider namespace the quota belongs to. Possible values are: 'Microsoft.Compute', 'Microsoft.Network', 'Microsoft.Storage'.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "location",
+				Description: "The location the quota applies to.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "unit",
+				Description: "The unit of measurement of the quota, e.g. 'Count'.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "current_value",
+				Description: "The current usage of the resource.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "limit",
+				Description: "The maximum usage allowed for the resource.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "usage_percentage",
+				Description: "The current usage as a percentage of the limit.",
+				Type:        proto.ColumnType_DOUBLE,
+				Transform:   transform.From(calculateSubscriptionQuotaUsagePercentage),
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ResourceName"),
+			},
+			{
+				Name:        "akas",
+				Description: ColumnDescriptionAkas,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("ID").Transform(idToAkas),
+			},
+		}),
+	}
+}
+
+// SubscriptionQuotaInfo is a quota usage of one of the resource providers,
+// which each return usages with slightly different types
+type SubscriptionQuotaInfo struct {
+	ID           *string
+	Name         *string
+	ResourceName *string
+	Namespace    string
+	Location     string
+	Unit         string
+	CurrentValue *int64
+	Limit        *int64
+}
+
+//// LIST FUNCTION
+
+func listSubscriptionQuotas(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	location := h.Item.(sub.Location)
+	if location.Name == nil {
+		return nil, nil
+	}
+
+	// Only list the quotas of the location and namespace given in the where clause, if any
+	if d.EqualsQuals["location"] != nil && d.EqualsQuals["location"].GetStringValue() != *location.Name {
+		return nil, nil
+	}
+	namespace := d.EqualsQuals["namespace"].GetStringValue()
+
+	session, err := GetNewSession(ctx, d, "MANAGEMENT")
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_subscription_quota.listSubscriptionQuotas", "session_error", err)
+		return nil, err
+	}
+
+	if namespace == "" || namespace == quotaNamespaceCompute {
+		if err := listSubscriptionComputeQuotas(ctx, d, session, *location.Name); err != nil {
+			return nil, err
+		}
+	}
+	if namespace == "" || namespace == quotaNamespaceNetwork {
+		if err := listSubscriptionNetworkQuotas(ctx, d, session, *location.Name); err != nil {
+			return nil, err
+		}
+	}
+	if namespace == "" || namespace == quotaNamespaceStorage {
+		if err := listSubscriptionStorageQuotas(ctx, d, session, *location.Name); err != nil {
+			return nil, err
+		}
+	}
+
+	return nil, nil
+}
+
+func listSubscriptionComputeQuotas(ctx context.Context, d *plugin.QueryData, session *Session, location string) error {
+	client := compute.NewUsageClientWithBaseURI(session.ResourceManagerEndpoint, session.SubscriptionID)
+	client.Authorizer = session.Authorizer
+
+	result, err := client.List(ctx, location)
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_subscription_quota.listSubscriptionComputeQuotas", "api_error", err)
+		return err
+	}
+
+	for {
+		for _, usage := range result.Values() {
+			info := SubscriptionQuotaInfo{
+				Namespace: quotaNamespaceCompute,
+				Location:  location,
+				Unit:      types.SafeString(usage.Unit),
+				Limit:     usage.Limit,
+			}
+			if usage.CurrentValue != nil {
+				currentValue := int64(*usage.CurrentValue)
+				info.CurrentValue = &currentValue
+			}
+			if usage.Name != nil {
+				info.Name = usage.Name.Value
+				info.ResourceName = usage.Name.LocalizedValue
+			}
+			d.StreamListItem(ctx, subscriptionQuotaWithID(session.SubscriptionID, info))
+			// Check if context has been cancelled or if the limit has been hit (if specified)
+			// if there is a limit, it will return the number of rows required to reach this limit
+			if d.RowsRemaining(ctx) == 0 {
+				return nil
+			}
+		}
+		if !result.NotDone() {
+			return nil
+		}
+		if err := result.NextWithContext(ctx); err != nil {
+			plugin.Logger(ctx).Error("azure_subscription_quota.listSubscriptionComputeQuotas", "paging_error", err)
+			return err
+		}
+	}
+}
+
+func listSubscriptionNetworkQuotas(ctx context.Context, d *plugin.QueryData, session *Session, location string) error {
+	client := network.NewUsagesClientWithBaseURI(session.ResourceManagerEndpoint, session.SubscriptionID)
+	client.Authorizer = session.Authorizer
+
+	result, err := client.List(ctx, location)
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_subscription_quota.listSubscriptionNetworkQuotas", "api_error", err)
+		return err
+	}
+
+	for {
+		for _, usage := range result.Values() {
+			info := SubscriptionQuotaInfo{
+				Namespace:    quotaNamespaceNetwork,
+				Location:     location,
+				Unit:         types.SafeString(usage.Unit),
+				CurrentValue: usage.CurrentValue,
+				Limit:        usage.Limit,
+			}
+			if usage.Name != nil {
+				info.Name = usage.Name.Value
+				info.ResourceName = usage.Name.LocalizedValue
+			}
+			d.StreamListItem(ctx, subscriptionQuotaWithID(session.SubscriptionID, info))
+			// Check if context has been cancelled or if the limit has been hit (if specified)
+			// if there is a limit, it will return the number of rows required to reach this limit
+			if d.RowsRemaining(ctx) == 0 {
+				return nil
+			}
+		}
+		if !result.NotDone() {
+			return nil
+		}
+		if err := result.NextWithContext(ctx); err != nil {
+			plugin.Logger(ctx).Error("azure_subscription_quota.listSubscriptionNetworkQuotas", "paging_error", err)
+			return err
+		}
+	}
+}
+
+func listSubscriptionStorageQuotas(ctx context.Context, d *plugin.QueryData, session *Session, location string) error {
+	client := storage.NewUsagesClientWithBaseURI(session.ResourceManagerEndpoint, session.SubscriptionID)
+	client.Authorizer = session.Authorizer
+
+	result, err := client.ListByLocation(ctx, location)
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_subscription_quota.listSubscriptionStorageQuotas", "api_error", err)
+		return err
+	}
+	if result.Value == nil {
+		return nil
+	}
+
+	for _, usage := range *result.Value {
+		info := SubscriptionQuotaInfo{
+			Namespace: quotaNamespaceStorage,
+			Location:  location,
+			Unit:      string(usage.Unit),
+		}
+		if usage.CurrentValue != nil {
+			currentValue := int64(*usage.CurrentValue)
+			info.CurrentValue = &currentValue
+		}
+		if usage.Limit != nil {
+			limit := int64(*usage.Limit)
+			info.Limit = &limit
+		}
+		if usage.Name != nil {
+			info.Name = usage.Name.Value
+			info.ResourceName = usage.Name.LocalizedValue
+		}
+		d.StreamListItem(ctx, subscriptionQuotaWithID(session.SubscriptionID, info))
+		// Check if context has been cancelled or if the limit has been hit (if specified)
+		// if there is a limit, it will return the number of rows required to reach this limit
+		if d.RowsRemaining(ctx) == 0 {
+			return nil
+		}
+	}
+
+	return nil
+}
+
+//// UTILITY FUNCTIONS
+
+// The compute and storage usage APIs do not return an ID, so one is built from
+// the path of the usages API for all the providers, for consistency
+func subscriptionQuotaWithID(subscriptionID string, info SubscriptionQuotaInfo) SubscriptionQuotaInfo {
+	if info.Name != nil {
+		id := fmt.Sprintf("/subscriptions/%s/providers/%s/locations/%s/usages/%s", subscriptionID, info.Namespace, info.Location, *info.Name)
+		info.ID = &id
+	}
+	return info
+}
+
+//// TRANSFORM FUNCTIONS
+
+func calculateSubscriptionQuotaUsagePercentage(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	info := d.HydrateItem.(SubscriptionQuotaInfo)
+	if info.CurrentValue == nil || info.Limit == nil || *info.Limit <= 0 {
+		return nil, nil
+	}
+	return float64(*info.CurrentValue) / float64(*info.Limit) * 100, nil
+}
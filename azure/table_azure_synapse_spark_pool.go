@@ -0,0 +1,289 @@
+package azure
+
+import (
+	"context"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/profiles/latest/synapse/mgmt/synapse"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+)
+
+//// TABLE DEFINITION
+
+func tableAzureSynapseSparkPool(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "azure_synapse_spark_pool",
+		Description: "Azure Synapse Spark Pool",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.AllColumns([]string{"workspace_name", "name", "resource_group"}),
+			Hydrate:    getSynapseSparkPool,
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: isNotFoundError([]string{"ResourceNotFound", "ResourceGroupNotFound", "BigDataPoolNotFound", "404"}),
+			},
+		},
+		List: &plugin.ListConfig{
+			Hydrate:       listSynapseSparkPools,
+			ParentHydrate: listSynapseWorkspaces,
+		},
+		Columns: azureColumns([]*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name of the Spark pool.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "id",
+				Description: "The ID of the Spark pool.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromGo(),
+			},
+			{
+				Name:        "workspace_name",
+				Description: "The name of the Synapse workspace the Spark pool belongs to.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "type",
+				Description: "The type of the resource.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "provisioning_state",
+				Description: "The state of the Spark pool.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("BigDataPoolResourceProperties.ProvisioningState"),
+			},
+			{
+				Name:        "creation_date",
+				Description: "The time when the Spark pool was created.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("BigDataPoolResourceProperties.CreationDate").Transform(convertDateToTime),
+			},
+			{
+				Name:        "last_succeeded_timestamp",
+				Description: "The time when the Spark pool was last updated successfully.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("BigDataPoolResourceProperties.LastSucceededTimestamp").Transform(convertDateToTime),
+			},
+			{
+				Name:        "spark_version",
+				Description: "The Apache Spark version.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("BigDataPoolResourceProperties.SparkVersion"),
+			},
+			{
+				Name:        "node_size_family",
+				Description: "The kind of nodes that the Spark pool provides. Possible values include: 'None', 'MemoryOptimized', 'HardwareAcceleratedFPGA', 'HardwareAcceleratedGPU'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("BigDataPoolResourceProperties.NodeSizeFamily"),
+			},
+			{
+				Name:        "node_size",
+				Description: "The level of compute power that each node in the Spark pool has. Possible values include: 'None', 'Small', 'Medium', 'Large', 'XLarge', 'XXLarge', 'XXXLarge'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("BigDataPoolResourceProperties.NodeSize"),
+			},
+			{
+				Name:        "node_count",
+				Description: "The number of nodes in the Spark pool.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("BigDataPoolResourceProperties.NodeCount"),
+			},
+			{
+				Name:        "is_compute_isolation_enabled",
+				Description: "Indicates whether compute isolation is required.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("BigDataPoolResourceProperties.IsComputeIsolationEnabled"),
+			},
+			{
+				Name:        "session_level_packages_enabled",
+				Description: "Indicates whether session level packages are enabled.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("BigDataPoolResourceProperties.SessionLevelPackagesEnabled"),
+			},
+			{
+				Name:        "cache_size",
+				Description: "The cache size of the Spark pool.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("BigDataPoolResourceProperties.CacheSize"),
+			},
+			{
+				Name:        "spark_events_folder",
+				Description: "The Spark events folder.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("BigDataPoolResourceProperties.SparkEventsFolder"),
+			},
+			{
+				Name:        "default_spark_log_folder",
+				Description: "The default folder where Spark logs will be written.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("BigDataPoolResourceProperties.DefaultSparkLogFolder"),
+			},
+			{
+				Name:        "auto_scale",
+				Description: "The auto-scaling properties of the Spark pool.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("BigDataPoolResourceProperties.AutoScale"),
+			},
+			{
+				Name:        "auto_pause",
+				Description: "The auto-pausing properties of the Spark pool.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("BigDataPoolResourceProperties.AutoPause"),
+			},
+			{
+				Name:        "dynamic_executor_allocation",
+				Description: "The dynamic executor allocation properties of the Spark pool.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("BigDataPoolResourceProperties.DynamicExecutorAllocation"),
+			},
+			{
+				Name:        "library_requirements",
+				Description: "The library requirements of the Spark pool.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("BigDataPoolResourceProperties.LibraryRequirements"),
+			},
+			{
+				Name:        "custom_libraries",
+				Description: "The list of custom libraries installed in the Spark pool.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("BigDataPoolResourceProperties.CustomLibraries"),
+			},
+			{
+				Name:        "spark_config_properties",
+				Description: "The Spark configuration file to specify additional properties.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("BigDataPoolResourceProperties.SparkConfigProperties"),
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+			{
+				Name:        "tags",
+				Description: ColumnDescriptionTags,
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "akas",
+				Description: ColumnDescriptionAkas,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("ID").Transform(idToAkas),
+			},
+
+			// Azure standard columns
+			{
+				Name:        "region",
+				Description: ColumnDescriptionRegion,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Location").Transform(toLower),
+			},
+			{
+				Name:        "resource_group",
+				Description: ColumnDescriptionResourceGroup,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ID").Transform(extractResourceGroupFromID),
+			},
+		}),
+	}
+}
+
+type SynapseSparkPoolInfo = struct {
+	synapse.BigDataPoolResourceInfo
+	WorkspaceName *string
+}
+
+//// LIST FUNCTION
+
+func listSynapseSparkPools(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	// Get the details of synapse workspace
+	workspace := h.Item.(synapse.Workspace)
+	resourceGroup := strings.Split(*workspace.ID, "/")[4]
+
+	session, err := GetNewSession(ctx, d, "MANAGEMENT")
+	if err != nil {
+		return nil, err
+	}
+	subscriptionID := session.SubscriptionID
+
+	client := synapse.NewBigDataPoolsClientWithBaseURI(session.ResourceManagerEndpoint, subscriptionID)
+	client.Authorizer = session.Authorizer
+
+	result, err := client.ListByWorkspace(ctx, resourceGroup, *workspace.Name)
+	if err != nil {
+		plugin.Logger(ctx).Error("listSynapseSparkPools", "list", err)
+		return nil, err
+	}
+
+	for _, pool := range result.Values() {
+		d.StreamListItem(ctx, SynapseSparkPoolInfo{pool, workspace.Name})
+		// Check if context has been cancelled or if the limit has been hit (if specified)
+		// if there is a limit, it will return the number of rows required to reach this limit
+		if d.RowsRemaining(ctx) == 0 {
+			return nil, nil
+		}
+	}
+
+	for result.NotDone() {
+		err = result.NextWithContext(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("listSynapseSparkPools", "list_paging", err)
+			return nil, err
+		}
+		for _, pool := range result.Values() {
+			d.StreamListItem(ctx, SynapseSparkPoolInfo{pool, workspace.Name})
+			// Check if context has been cancelled or if the limit has been hit (if specified)
+			// if there is a limit, it will return the number of rows required to reach this limit
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, err
+}
+
+//// HYDRATE FUNCTIONS
+
+func getSynapseSparkPool(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("getSynapseSparkPool")
+
+	workspaceName := d.EqualsQuals["workspace_name"].GetStringValue()
+	name := d.EqualsQuals["name"].GetStringValue()
+	resourceGroup := d.EqualsQuals["resource_group"].GetStringValue()
+
+	// Handle empty check
+	if workspaceName == "" || name == "" || resourceGroup == "" {
+		return nil, nil
+	}
+
+	session, err := GetNewSession(ctx, d, "MANAGEMENT")
+	if err != nil {
+		return nil, err
+	}
+	subscriptionID := session.SubscriptionID
+
+	client := synapse.NewBigDataPoolsClientWithBaseURI(session.ResourceManagerEndpoint, subscriptionID)
+	client.Authorizer = session.Authorizer
+
+	op, err := client.Get(ctx, resourceGroup, workspaceName, name)
+	if err != nil {
+		plugin.Logger(ctx).Error("getSynapseSparkPool", "get", err)
+		return nil, err
+	}
+
+	// In some cases resource does not give any notFound error
+	// instead of notFound error, it returns empty data
+	if op.ID != nil {
+		return SynapseSparkPoolInfo{op, &workspaceName}, nil
+	}
+
+	return nil, nil
+}
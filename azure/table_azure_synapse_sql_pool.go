@@ -0,0 +1,259 @@
+package azure
+
+import (
+	"context"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/profiles/latest/synapse/mgmt/synapse"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+)
+
+//// TABLE DEFINITION
+
+func tableAzureSynapseSQLPool(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "azure_synapse_sql_pool",
+		Description: "Azure Synapse SQL Pool",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.AllColumns([]string{"workspace_name", "name", "resource_group"}),
+			Hydrate:    getSynapseSQLPool,
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: isNotFoundError([]string{"ResourceNotFound", "ResourceGroupNotFound", "SqlPoolNotFound", "404"}),
+			},
+		},
+		List: &plugin.ListConfig{
+			Hydrate:       listSynapseSQLPools,
+			ParentHydrate: listSynapseWorkspaces,
+		},
+		Columns: azureColumns([]*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name of the SQL pool.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "id",
+				Description: "The ID of the SQL pool.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromGo(),
+			},
+			{
+				Name:        "workspace_name",
+				Description: "The name of the Synapse workspace the SQL pool belongs to.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "type",
+				Description: "The type of the resource.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "provisioning_state",
+				Description: "The provisioning state of the SQL pool.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("SQLPoolResourceProperties.ProvisioningState"),
+			},
+			{
+				Name:        "status",
+				Description: "The status of the SQL pool, e.g. Online or Paused.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("SQLPoolResourceProperties.Status"),
+			},
+			{
+				Name:        "sku_name",
+				Description: "The name of the SKU, e.g. DW100c.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Sku.Name"),
+			},
+			{
+				Name:        "sku_tier",
+				Description: "The service tier of the SKU.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Sku.Tier"),
+			},
+			{
+				Name:        "sku_capacity",
+				Description: "The capacity of the SKU.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("Sku.Capacity"),
+			},
+			{
+				Name:        "collation",
+				Description: "The collation mode of the SQL pool.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("SQLPoolResourceProperties.Collation"),
+			},
+			{
+				Name:        "max_size_bytes",
+				Description: "The maximum size of the SQL pool, in bytes.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("SQLPoolResourceProperties.MaxSizeBytes"),
+			},
+			{
+				Name:        "storage_account_type",
+				Description: "The storage account type used to store backups for the SQL pool. Possible values include: 'GRS', 'LRS', 'ZRS'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("SQLPoolResourceProperties.StorageAccountType"),
+			},
+			{
+				Name:        "create_mode",
+				Description: "Specifies the mode of SQL pool creation. Possible values include: 'Default', 'PointInTimeRestore', 'Recovery', 'Restore'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("SQLPoolResourceProperties.CreateMode"),
+			},
+			{
+				Name:        "creation_date",
+				Description: "The date the SQL pool was created.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("SQLPoolResourceProperties.CreationDate").Transform(convertDateToTime),
+			},
+			{
+				Name:        "source_database_id",
+				Description: "The source database the SQL pool was created from, when the create mode is not Default.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("SQLPoolResourceProperties.SourceDatabaseID"),
+			},
+			{
+				Name:        "recoverable_database_id",
+				Description: "The backup database the SQL pool was restored from, when the create mode is Recovery.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("SQLPoolResourceProperties.RecoverableDatabaseID"),
+			},
+			{
+				Name:        "restore_point_in_time",
+				Description: "The point in time the source database was restored to, when the create mode is PointInTimeRestore.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("SQLPoolResourceProperties.RestorePointInTime").Transform(convertDateToTime),
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+			{
+				Name:        "tags",
+				Description: ColumnDescriptionTags,
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "akas",
+				Description: ColumnDescriptionAkas,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("ID").Transform(idToAkas),
+			},
+
+			// Azure standard columns
+			{
+				Name:        "region",
+				Description: ColumnDescriptionRegion,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Location").Transform(toLower),
+			},
+			{
+				Name:        "resource_group",
+				Description: ColumnDescriptionResourceGroup,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ID").Transform(extractResourceGroupFromID),
+			},
+		}),
+	}
+}
+
+type SynapseSQLPoolInfo = struct {
+	synapse.SQLPool
+	WorkspaceName *string
+}
+
+//// LIST FUNCTION
+
+func listSynapseSQLPools(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	// Get the details of synapse workspace
+	workspace := h.Item.(synapse.Workspace)
+	resourceGroup := strings.Split(*workspace.ID, "/")[4]
+
+	session, err := GetNewSession(ctx, d, "MANAGEMENT")
+	if err != nil {
+		return nil, err
+	}
+	subscriptionID := session.SubscriptionID
+
+	client := synapse.NewSQLPoolsClientWithBaseURI(session.ResourceManagerEndpoint, subscriptionID)
+	client.Authorizer = session.Authorizer
+
+	result, err := client.ListByWorkspace(ctx, resourceGroup, *workspace.Name)
+	if err != nil {
+		plugin.Logger(ctx).Error("listSynapseSQLPools", "list", err)
+		return nil, err
+	}
+
+	for _, pool := range result.Values() {
+		d.StreamListItem(ctx, SynapseSQLPoolInfo{pool, workspace.Name})
+		// Check if context has been cancelled or if the limit has been hit (if specified)
+		// if there is a limit, it will return the number of rows required to reach this limit
+		if d.RowsRemaining(ctx) == 0 {
+			return nil, nil
+		}
+	}
+
+	for result.NotDone() {
+		err = result.NextWithContext(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("listSynapseSQLPools", "list_paging", err)
+			return nil, err
+		}
+		for _, pool := range result.Values() {
+			d.StreamListItem(ctx, SynapseSQLPoolInfo{pool, workspace.Name})
+			// Check if context has been cancelled or if the limit has been hit (if specified)
+			// if there is a limit, it will return the number of rows required to reach this limit
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, err
+}
+
+//// HYDRATE FUNCTIONS
+
+func getSynapseSQLPool(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("getSynapseSQLPool")
+
+	workspaceName := d.EqualsQuals["workspace_name"].GetStringValue()
+	name := d.EqualsQuals["name"].GetStringValue()
+	resourceGroup := d.EqualsQuals["resource_group"].GetStringValue()
+
+	// Handle empty check
+	if workspaceName == "" || name == "" || resourceGroup == "" {
+		return nil, nil
+	}
+
+	session, err := GetNewSession(ctx, d, "MANAGEMENT")
+	if err != nil {
+		return nil, err
+	}
+	subscriptionID := session.SubscriptionID
+
+	client := synapse.NewSQLPoolsClientWithBaseURI(session.ResourceManagerEndpoint, subscriptionID)
+	client.Authorizer = session.Authorizer
+
+	op, err := client.Get(ctx, resourceGroup, workspaceName, name)
+	if err != nil {
+		plugin.Logger(ctx).Error("getSynapseSQLPool", "get", err)
+		return nil, err
+	}
+
+	// In some cases resource does not give any notFound error
+	// instead of notFound error, it returns empty data
+	if op.ID != nil {
+		return SynapseSQLPoolInfo{op, &workspaceName}, nil
+	}
+
+	return nil, nil
+}
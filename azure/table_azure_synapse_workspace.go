@@ -28,6 +28,13 @@ func tableAzureSynapseWorkspace(_ context.Context) *plugin.Table {
 		List: &plugin.ListConfig{
 			Hydrate: listSynapseWorkspaces,
 		},
+		HydrateConfig: []plugin.HydrateConfig{
+			{
+				Func:           listSynapseWorkspaceDiagnosticSettings,
+				MaxConcurrency: diagnosticSettingsMaxConcurrency,
+				Tags:           map[string]string{"service": "Microsoft.Insights", "action": "diagnosticSettings/list"},
+			},
+		},
 		Columns: azureColumns([]*plugin.Column{
 			{
 				Name:        "name",
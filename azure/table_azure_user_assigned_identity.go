@@ -0,0 +1,224 @@
+package azure
+
+import (
+	"context"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/profiles/preview/preview/msi/mgmt/msi"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+)
+
+//// TABLE DEFINITION
+
+func tableAzureUserAssignedIdentity(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "azure_user_assigned_identity",
+		Description: "Azure User Assigned Identity",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.AllColumns([]string{"name", "resource_group"}),
+			Hydrate:    getUserAssignedIdentity,
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: isNotFoundError([]string{"ResourceNotFound", "ResourceGroupNotFound", "404"}),
+			},
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listUserAssignedIdentities,
+		},
+		Columns: azureColumns([]*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name of the user assigned identity.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "id",
+				Description: "The ID of the user assigned identity.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromGo(),
+			},
+			{
+				Name:        "type",
+				Description: "The type of the resource.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "principal_id",
+				Description: "The ID of the service principal object associated with the identity.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("UserAssignedIdentityProperties.PrincipalID").Transform(transform.ToString),
+			},
+			{
+				Name:        "client_id",
+				Description: "The ID of the application associated with the identity.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("UserAssignedIdentityProperties.ClientID").Transform(transform.ToString),
+			},
+			{
+				Name:        "tenant_id",
+				Description: "The ID of the tenant the identity belongs to.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("UserAssignedIdentityProperties.TenantID").Transform(transform.ToString),
+			},
+			{
+				Name:        "federated_credentials_count",
+				Description: "The number of federated identity credentials configured on the identity.",
+				Type:        proto.ColumnType_INT,
+				Hydrate:     getUserAssignedIdentityFederatedCredentialsCount,
+				Transform:   transform.FromValue(),
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+			{
+				Name:        "tags",
+				Description: ColumnDescriptionTags,
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "akas",
+				Description: ColumnDescriptionAkas,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("ID").Transform(idToAkas),
+			},
+
+			// Azure standard columns
+			{
+				Name:        "region",
+				Description: ColumnDescriptionRegion,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Location").Transform(toLower),
+			},
+			{
+				Name:        "resource_group",
+				Description: ColumnDescriptionResourceGroup,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ID").Transform(extractResourceGroupFromID),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listUserAssignedIdentities(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	session, err := GetNewSession(ctx, d, "MANAGEMENT")
+	if err != nil {
+		return nil, err
+	}
+	subscriptionID := session.SubscriptionID
+
+	client := msi.NewUserAssignedIdentitiesClientWithBaseURI(session.ResourceManagerEndpoint, subscriptionID)
+	client.Authorizer = session.Authorizer
+
+	result, err := client.ListBySubscription(ctx)
+	if err != nil {
+		plugin.Logger(ctx).Error("listUserAssignedIdentities", "list", err)
+		return nil, err
+	}
+
+	for _, identity := range result.Values() {
+		d.StreamListItem(ctx, identity)
+		// Check if context has been cancelled or if the limit has been hit (if specified)
+		// if there is a limit, it will return the number of rows required to reach this limit
+		if d.RowsRemaining(ctx) == 0 {
+			return nil, nil
+		}
+	}
+
+	for result.NotDone() {
+		err = result.NextWithContext(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("listUserAssignedIdentities", "list_paging", err)
+			return nil, err
+		}
+		for _, identity := range result.Values() {
+			d.StreamListItem(ctx, identity)
+			// Check if context has been cancelled or if the limit has been hit (if specified)
+			// if there is a limit, it will return the number of rows required to reach this limit
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, err
+}
+
+//// HYDRATE FUNCTIONS
+
+func getUserAssignedIdentity(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("getUserAssignedIdentity")
+
+	name := d.EqualsQuals["name"].GetStringValue()
+	resourceGroup := d.EqualsQuals["resource_group"].GetStringValue()
+
+	// Handle empty name or resourceGroup
+	if name == "" || resourceGroup == "" {
+		return nil, nil
+	}
+
+	session, err := GetNewSession(ctx, d, "MANAGEMENT")
+	if err != nil {
+		return nil, err
+	}
+	subscriptionID := session.SubscriptionID
+
+	client := msi.NewUserAssignedIdentitiesClientWithBaseURI(session.ResourceManagerEndpoint, subscriptionID)
+	client.Authorizer = session.Authorizer
+
+	op, err := client.Get(ctx, resourceGroup, name)
+	if err != nil {
+		plugin.Logger(ctx).Error("getUserAssignedIdentity", "get", err)
+		return nil, err
+	}
+
+	// In some cases resource does not give any notFound error
+	// instead of notFound error, it returns empty data
+	if op.ID != nil {
+		return op, nil
+	}
+
+	return nil, nil
+}
+
+func getUserAssignedIdentityFederatedCredentialsCount(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("getUserAssignedIdentityFederatedCredentialsCount")
+
+	identity := h.Item.(msi.Identity)
+	resourceGroup := strings.Split(*identity.ID, "/")[4]
+
+	session, err := GetNewSession(ctx, d, "MANAGEMENT")
+	if err != nil {
+		return nil, err
+	}
+	subscriptionID := session.SubscriptionID
+
+	client := msi.NewFederatedIdentityCredentialsClientWithBaseURI(session.ResourceManagerEndpoint, subscriptionID)
+	client.Authorizer = session.Authorizer
+
+	result, err := client.List(ctx, resourceGroup, *identity.Name, nil, "")
+	if err != nil {
+		plugin.Logger(ctx).Error("getUserAssignedIdentityFederatedCredentialsCount", "list", err)
+		return nil, err
+	}
+
+	count := len(result.Values())
+	for result.NotDone() {
+		err = result.NextWithContext(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("getUserAssignedIdentityFederatedCredentialsCount", "list_paging", err)
+			return nil, err
+		}
+		count += len(result.Values())
+	}
+
+	return count, nil
+}
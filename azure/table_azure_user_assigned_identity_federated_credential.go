@@ -0,0 +1,206 @@
+package azure
+
+import (
+	"context"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/profiles/preview/preview/msi/mgmt/msi"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+)
+
+//// TABLE DEFINITION
+
+func tableAzureUserAssignedIdentityFederatedCredential(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "azure_user_assigned_identity_federated_credential",
+		Description: "Azure User Assigned Identity Federated Credential",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.AllColumns([]string{"user_assigned_identity_name", "name", "resource_group"}),
+			Hydrate:    getUserAssignedIdentityFederatedCredential,
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: isNotFoundError([]string{"ResourceNotFound", "ResourceGroupNotFound", "404"}),
+			},
+		},
+		List: &plugin.ListConfig{
+			Hydrate:       listUserAssignedIdentityFederatedCredentials,
+			ParentHydrate: listUserAssignedIdentities,
+		},
+		Columns: azureColumns([]*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name of the federated credential.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "id",
+				Description: "The ID of the federated credential.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromGo(),
+			},
+			{
+				Name:        "user_assigned_identity_name",
+				Description: "The name of the user assigned identity the federated credential belongs to.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("IdentityName"),
+			},
+			{
+				Name:        "type",
+				Description: "The type of the resource.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "issuer",
+				Description: "The URL of the issuer to be trusted, e.g. 'https://token.actions.githubusercontent.com'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("FederatedIdentityCredentialProperties.Issuer"),
+			},
+			{
+				Name:        "subject",
+				Description: "The identifier of the external identity, e.g. 'repo:my-org/my-repo:ref:refs/heads/main'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("FederatedIdentityCredentialProperties.Subject"),
+			},
+			{
+				Name:        "audiences",
+				Description: "The list of audiences that can appear in the issued token.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("FederatedIdentityCredentialProperties.Audiences"),
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+			{
+				Name:        "akas",
+				Description: ColumnDescriptionAkas,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("ID").Transform(idToAkas),
+			},
+
+			// Azure standard columns
+			{
+				Name:        "region",
+				Description: ColumnDescriptionRegion,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Location").Transform(toLower),
+			},
+			{
+				Name:        "resource_group",
+				Description: ColumnDescriptionResourceGroup,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ID").Transform(extractResourceGroupFromID),
+			},
+		}),
+	}
+}
+
+type UserAssignedIdentityFederatedCredentialInfo = struct {
+	msi.FederatedIdentityCredential
+	IdentityName *string
+	Location     *string
+}
+
+//// LIST FUNCTION
+
+func listUserAssignedIdentityFederatedCredentials(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	// Get the details of user assigned identity
+	identity := h.Item.(msi.Identity)
+	resourceGroup := strings.Split(*identity.ID, "/")[4]
+
+	session, err := GetNewSession(ctx, d, "MANAGEMENT")
+	if err != nil {
+		return nil, err
+	}
+	subscriptionID := session.SubscriptionID
+
+	client := msi.NewFederatedIdentityCredentialsClientWithBaseURI(session.ResourceManagerEndpoint, subscriptionID)
+	client.Authorizer = session.Authorizer
+
+	result, err := client.List(ctx, resourceGroup, *identity.Name, nil, "")
+	if err != nil {
+		plugin.Logger(ctx).Error("listUserAssignedIdentityFederatedCredentials", "list", err)
+		return nil, err
+	}
+
+	for _, credential := range result.Values() {
+		d.StreamListItem(ctx, UserAssignedIdentityFederatedCredentialInfo{credential, identity.Name, identity.Location})
+		// Check if context has been cancelled or if the limit has been hit (if specified)
+		// if there is a limit, it will return the number of rows required to reach this limit
+		if d.RowsRemaining(ctx) == 0 {
+			return nil, nil
+		}
+	}
+
+	for result.NotDone() {
+		err = result.NextWithContext(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("listUserAssignedIdentityFederatedCredentials", "list_paging", err)
+			return nil, err
+		}
+		for _, credential := range result.Values() {
+			d.StreamListItem(ctx, UserAssignedIdentityFederatedCredentialInfo{credential, identity.Name, identity.Location})
+			// Check if context has been cancelled or if the limit has been hit (if specified)
+			// if there is a limit, it will return the number of rows required to reach this limit
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, err
+}
+
+//// HYDRATE FUNCTIONS
+
+func getUserAssignedIdentityFederatedCredential(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("getUserAssignedIdentityFederatedCredential")
+
+	identityName := d.EqualsQuals["user_assigned_identity_name"].GetStringValue()
+	name := d.EqualsQuals["name"].GetStringValue()
+	resourceGroup := d.EqualsQuals["resource_group"].GetStringValue()
+
+	// Handle empty check
+	if identityName == "" || name == "" || resourceGroup == "" {
+		return nil, nil
+	}
+
+	session, err := GetNewSession(ctx, d, "MANAGEMENT")
+	if err != nil {
+		return nil, err
+	}
+	subscriptionID := session.SubscriptionID
+
+	client := msi.NewFederatedIdentityCredentialsClientWithBaseURI(session.ResourceManagerEndpoint, subscriptionID)
+	client.Authorizer = session.Authorizer
+
+	op, err := client.Get(ctx, resourceGroup, identityName, name)
+	if err != nil {
+		plugin.Logger(ctx).Error("getUserAssignedIdentityFederatedCredential", "get", err)
+		return nil, err
+	}
+
+	// In some cases resource does not give any notFound error
+	// instead of notFound error, it returns empty data
+	if op.ID == nil {
+		return nil, nil
+	}
+
+	// The federated credential does not return the location, so it is taken from the identity
+	identityClient := msi.NewUserAssignedIdentitiesClientWithBaseURI(session.ResourceManagerEndpoint, subscriptionID)
+	identityClient.Authorizer = session.Authorizer
+
+	identity, err := identityClient.Get(ctx, resourceGroup, identityName)
+	if err != nil {
+		plugin.Logger(ctx).Error("getUserAssignedIdentityFederatedCredential", "get_identity", err)
+		return nil, err
+	}
+
+	return UserAssignedIdentityFederatedCredentialInfo{op, identity.Name, identity.Location}, nil
+}
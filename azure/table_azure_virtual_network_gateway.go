@@ -85,7 +85,7 @@ func tableAzureVirtualNetworkGateway(_ context.Context) *plugin.Table {
 				Name:        "enable_dns_forwarding",
 				Description: "Indicates whether DNS forwarding is enabled, or not.",
 				Type:        proto.ColumnType_BOOL,
-				Transform:   transform.FromField("VirtualNetworkGatewayPropertiesFormat.EnableVMProtection"),
+				Transform:   transform.FromField("VirtualNetworkGatewayPropertiesFormat.EnableDNSForwarding"),
 			},
 			{
 				Name:        "enable_private_ip_address",
@@ -165,6 +165,18 @@ func tableAzureVirtualNetworkGateway(_ context.Context) *plugin.Table {
 				Type:        proto.ColumnType_JSON,
 				Transform:   transform.FromField("VirtualNetworkGatewayPropertiesFormat.VpnClientConfiguration"),
 			},
+			{
+				Name:        "radius_server_address",
+				Description: "The radius server address property of the virtual network gateway resource for vpn client connection.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("VirtualNetworkGatewayPropertiesFormat.VpnClientConfiguration.RadiusServerAddress"),
+			},
+			{
+				Name:        "vpn_client_protocols",
+				Description: "The vpn client protocols enabled for the virtual network gateway.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("VirtualNetworkGatewayPropertiesFormat.VpnClientConfiguration.VpnClientProtocols"),
+			},
 
 			// Steampipe standard columns
 			{
@@ -0,0 +1,270 @@
+package azure
+
+import (
+	"context"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/profiles/latest/network/mgmt/network"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+)
+
+//// TABLE DEFINITION
+
+func tableAzureVirtualNetworkPeering(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "azure_virtual_network_peering",
+		Description: "Azure Virtual Network Peering",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.AllColumns([]string{"virtual_network_name", "name", "resource_group"}),
+			Hydrate:    getVirtualNetworkPeering,
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: isNotFoundError([]string{"ResourceNotFound", "ResourceGroupNotFound", "NotFound", "404"}),
+			},
+		},
+		List: &plugin.ListConfig{
+			Hydrate:       listVirtualNetworkPeerings,
+			ParentHydrate: listVirtualNetworks,
+		},
+		Columns: azureColumns([]*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name of the resource that is unique within a resource group.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "id",
+				Description: "The resource ID.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromGo(),
+			},
+			{
+				Name:        "virtual_network_name",
+				Description: "The name of the virtual network the peering belongs to.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "type",
+				Description: "The type of the resource.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "etag",
+				Description: "A unique read-only string that changes whenever the resource is updated.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "peering_state",
+				Description: "The status of the virtual network peering. Possible values include: 'Initiated', 'Connected', 'Disconnected'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("VirtualNetworkPeeringPropertiesFormat.PeeringState"),
+			},
+			{
+				Name:        "peering_sync_level",
+				Description: "The peering sync status of the virtual network peering. Possible values include: 'FullyInSync', 'RemoteNotInSync', 'LocalNotInSync', 'LocalAndRemoteNotInSync'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("VirtualNetworkPeeringPropertiesFormat.PeeringSyncLevel"),
+			},
+			{
+				Name:        "provisioning_state",
+				Description: "The provisioning state of the virtual network peering resource.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("VirtualNetworkPeeringPropertiesFormat.ProvisioningState"),
+			},
+			{
+				Name:        "remote_virtual_network_id",
+				Description: "The ID of the remote virtual network.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("VirtualNetworkPeeringPropertiesFormat.RemoteVirtualNetwork.ID"),
+			},
+			{
+				Name:        "allow_virtual_network_access",
+				Description: "Whether the VMs in the local virtual network space would be able to access the VMs in remote virtual network space.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("VirtualNetworkPeeringPropertiesFormat.AllowVirtualNetworkAccess"),
+			},
+			{
+				Name:        "allow_forwarded_traffic",
+				Description: "Whether the forwarded traffic from the VMs in the local virtual network will be allowed/disallowed in remote virtual network.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("VirtualNetworkPeeringPropertiesFormat.AllowForwardedTraffic"),
+			},
+			{
+				Name:        "allow_gateway_transit",
+				Description: "If gateway links can be used in remote virtual networking to link to this virtual network.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("VirtualNetworkPeeringPropertiesFormat.AllowGatewayTransit"),
+			},
+			{
+				Name:        "use_remote_gateways",
+				Description: "If remote gateways can be used on this virtual network.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("VirtualNetworkPeeringPropertiesFormat.UseRemoteGateways"),
+			},
+			{
+				Name:        "do_not_verify_remote_gateways",
+				Description: "If we need to verify the provisioning state of the remote gateway.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("VirtualNetworkPeeringPropertiesFormat.DoNotVerifyRemoteGateways"),
+			},
+			{
+				Name:        "resource_guid",
+				Description: "The resource GUID property of the virtual network peering resource.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("VirtualNetworkPeeringPropertiesFormat.ResourceGUID"),
+			},
+			{
+				Name:        "remote_address_space",
+				Description: "The reference to the address space peered with the remote virtual network.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("VirtualNetworkPeeringPropertiesFormat.RemoteAddressSpace"),
+			},
+			{
+				Name:        "remote_virtual_network_address_space",
+				Description: "The reference to the current address space of the remote virtual network.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("VirtualNetworkPeeringPropertiesFormat.RemoteVirtualNetworkAddressSpace"),
+			},
+			{
+				Name:        "remote_bgp_communities",
+				Description: "The reference to the remote virtual network's Bgp Communities.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("VirtualNetworkPeeringPropertiesFormat.RemoteBgpCommunities"),
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+			{
+				Name:        "akas",
+				Description: ColumnDescriptionAkas,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("ID").Transform(idToAkas),
+			},
+
+			// Azure standard columns
+			{
+				Name:        "region",
+				Description: ColumnDescriptionRegion,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Location").Transform(toLower),
+			},
+			{
+				Name:        "resource_group",
+				Description: ColumnDescriptionResourceGroup,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ID").Transform(extractResourceGroupFromID),
+			},
+		}),
+	}
+}
+
+type VirtualNetworkPeeringInfo = struct {
+	network.VirtualNetworkPeering
+	VirtualNetworkName *string
+	Location           *string
+}
+
+//// LIST FUNCTION
+
+func listVirtualNetworkPeerings(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	// Get the details of virtual network
+	virtualNetwork := h.Item.(network.VirtualNetwork)
+	resourceGroup := strings.Split(*virtualNetwork.ID, "/")[4]
+
+	session, err := GetNewSession(ctx, d, "MANAGEMENT")
+	if err != nil {
+		return nil, err
+	}
+	subscriptionID := session.SubscriptionID
+
+	client := network.NewVirtualNetworkPeeringsClientWithBaseURI(session.ResourceManagerEndpoint, subscriptionID)
+	client.Authorizer = session.Authorizer
+
+	result, err := client.List(ctx, resourceGroup, *virtualNetwork.Name)
+	if err != nil {
+		plugin.Logger(ctx).Error("listVirtualNetworkPeerings", "list", err)
+		return nil, err
+	}
+
+	for _, peering := range result.Values() {
+		d.StreamListItem(ctx, VirtualNetworkPeeringInfo{peering, virtualNetwork.Name, virtualNetwork.Location})
+		// Check if context has been cancelled or if the limit has been hit (if specified)
+		// if there is a limit, it will return the number of rows required to reach this limit
+		if d.RowsRemaining(ctx) == 0 {
+			return nil, nil
+		}
+	}
+
+	for result.NotDone() {
+		err = result.NextWithContext(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("listVirtualNetworkPeerings", "list_paging", err)
+			return nil, err
+		}
+		for _, peering := range result.Values() {
+			d.StreamListItem(ctx, VirtualNetworkPeeringInfo{peering, virtualNetwork.Name, virtualNetwork.Location})
+			// Check if context has been cancelled or if the limit has been hit (if specified)
+			// if there is a limit, it will return the number of rows required to reach this limit
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, err
+}
+
+//// HYDRATE FUNCTIONS
+
+func getVirtualNetworkPeering(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("getVirtualNetworkPeering")
+
+	virtualNetworkName := d.EqualsQuals["virtual_network_name"].GetStringValue()
+	name := d.EqualsQuals["name"].GetStringValue()
+	resourceGroup := d.EqualsQuals["resource_group"].GetStringValue()
+
+	// Handle empty check
+	if virtualNetworkName == "" || name == "" || resourceGroup == "" {
+		return nil, nil
+	}
+
+	session, err := GetNewSession(ctx, d, "MANAGEMENT")
+	if err != nil {
+		return nil, err
+	}
+	subscriptionID := session.SubscriptionID
+
+	client := network.NewVirtualNetworkPeeringsClientWithBaseURI(session.ResourceManagerEndpoint, subscriptionID)
+	client.Authorizer = session.Authorizer
+
+	op, err := client.Get(ctx, resourceGroup, virtualNetworkName, name)
+	if err != nil {
+		plugin.Logger(ctx).Error("getVirtualNetworkPeering", "get", err)
+		return nil, err
+	}
+
+	// In some cases resource does not give any notFound error
+	// instead of notFound error, it returns empty data
+	if op.ID == nil {
+		return nil, nil
+	}
+
+	// The peering does not return the location, so it is taken from the virtual network
+	networkClient := network.NewVirtualNetworksClientWithBaseURI(session.ResourceManagerEndpoint, subscriptionID)
+	networkClient.Authorizer = session.Authorizer
+
+	virtualNetwork, err := networkClient.Get(ctx, resourceGroup, virtualNetworkName, "")
+	if err != nil {
+		plugin.Logger(ctx).Error("getVirtualNetworkPeering", "get_virtual_network", err)
+		return nil, err
+	}
+
+	return VirtualNetworkPeeringInfo{op, virtualNetwork.Name, virtualNetwork.Location}, nil
+}
@@ -0,0 +1,237 @@
+package azure
+
+import (
+	"context"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/profiles/latest/network/mgmt/network"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+)
+
+//// TABLE DEFINITION ////
+
+func tableAzureVirtualNetworkTap(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "azure_virtual_network_tap",
+		Description: "Azure Virtual Network TAP",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.AllColumns([]string{"name", "resource_group"}),
+			Hydrate:    getVirtualNetworkTap,
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: isNotFoundError([]string{"ResourceNotFound", "ResourceGroupNotFound", "404"}),
+			},
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listVirtualNetworkTaps,
+		},
+		Columns: azureColumns([]*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name of the virtual network TAP.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "id",
+				Description: "The unique ID of the virtual network TAP.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromGo(),
+			},
+			{
+				Name:        "type",
+				Description: "The type of the resource.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "provisioning_state",
+				Description: "The provisioning state of the virtual network TAP. Possible values include: 'Succeeded', 'Updating', 'Deleting', 'Failed'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("VirtualNetworkTapPropertiesFormat.ProvisioningState"),
+			},
+			{
+				Name:        "resource_guid",
+				Description: "The resource GUID of the virtual network TAP.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("VirtualNetworkTapPropertiesFormat.ResourceGUID"),
+			},
+			{
+				Name:        "destination_network_interface_ip_configuration_id",
+				Description: "The ID of the IP configuration of the network interface that receives the mirrored traffic.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("VirtualNetworkTapPropertiesFormat.DestinationNetworkInterfaceIPConfiguration.ID"),
+			},
+			{
+				Name:        "destination_load_balancer_frontend_ip_configuration_id",
+				Description: "The ID of the frontend IP configuration of the internal load balancer that receives the mirrored traffic.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("VirtualNetworkTapPropertiesFormat.DestinationLoadBalancerFrontEndIPConfiguration.ID"),
+			},
+			{
+				Name:        "destination_port",
+				Description: "The VXLAN destination port that receives the mirrored traffic.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("VirtualNetworkTapPropertiesFormat.DestinationPort"),
+			},
+			{
+				Name:        "network_interface_tap_configurations",
+				Description: "The TAP configurations of the network interfaces whose traffic is mirrored.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("VirtualNetworkTapPropertiesFormat.NetworkInterfaceTapConfigurations").Transform(extractVirtualNetworkTapInterfaceConfigurations),
+			},
+			{
+				Name:        "etag",
+				Description: "A unique read-only string that changes whenever the resource is updated.",
+				Type:        proto.ColumnType_STRING,
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+			{
+				Name:        "tags",
+				Description: ColumnDescriptionTags,
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "akas",
+				Description: ColumnDescriptionAkas,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("ID").Transform(idToAkas),
+			},
+
+			// Azure standard columns
+			{
+				Name:        "region",
+				Description: ColumnDescriptionRegion,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Location").Transform(toLower),
+			},
+			{
+				Name:        "resource_group",
+				Description: ColumnDescriptionResourceGroup,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ID").Transform(extractResourceGroupFromID),
+			},
+		}),
+	}
+}
+
+//// FETCH FUNCTIONS ////
+
+func listVirtualNetworkTaps(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	session, err := GetNewSession(ctx, d, "MANAGEMENT")
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_virtual_network_tap.listVirtualNetworkTaps", "session_error", err)
+		return nil, err
+	}
+	subscriptionID := session.SubscriptionID
+
+	networkClient := network.NewVirtualNetworkTapsClientWithBaseURI(session.ResourceManagerEndpoint, subscriptionID)
+	networkClient.Authorizer = session.Authorizer
+
+	result, err := networkClient.ListAll(ctx)
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_virtual_network_tap.listVirtualNetworkTaps", "api_error", err)
+		return nil, err
+	}
+
+	for _, tap := range result.Values() {
+		d.StreamListItem(ctx, tap)
+		// Check if context has been cancelled or if the limit has been hit (if specified)
+		// if there is a limit, it will return the number of rows required to reach this limit
+		if d.RowsRemaining(ctx) == 0 {
+			return nil, nil
+		}
+	}
+
+	for result.NotDone() {
+		err = result.NextWithContext(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("azure_virtual_network_tap.listVirtualNetworkTaps", "paging_error", err)
+			return nil, err
+		}
+		for _, tap := range result.Values() {
+			d.StreamListItem(ctx, tap)
+			// Check if context has been cancelled or if the limit has been hit (if specified)
+			// if there is a limit, it will return the number of rows required to reach this limit
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, err
+}
+
+//// HYDRATE FUNCTIONS ////
+
+func getVirtualNetworkTap(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	name := d.EqualsQuals["name"].GetStringValue()
+	resourceGroup := d.EqualsQuals["resource_group"].GetStringValue()
+
+	// Handle empty name or resourceGroup
+	if name == "" || resourceGroup == "" {
+		return nil, nil
+	}
+
+	session, err := GetNewSession(ctx, d, "MANAGEMENT")
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_virtual_network_tap.getVirtualNetworkTap", "session_error", err)
+		return nil, err
+	}
+	subscriptionID := session.SubscriptionID
+
+	networkClient := network.NewVirtualNetworkTapsClientWithBaseURI(session.ResourceManagerEndpoint, subscriptionID)
+	networkClient.Authorizer = session.Authorizer
+
+	op, err := networkClient.Get(ctx, resourceGroup, name)
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_virtual_network_tap.getVirtualNetworkTap", "api_error", err)
+		return nil, err
+	}
+
+	// In some cases resource does not give any notFound error
+	// instead of notFound error, it returns empty data
+	if op.ID != nil {
+		return op, nil
+	}
+
+	return nil, nil
+}
+
+//// TRANSFORM FUNCTIONS
+
+// The tap configurations embed the virtual network TAP itself, so only their
+// identifiers and the tapped network interface are returned
+func extractVirtualNetworkTapInterfaceConfigurations(ctx context.Context, d *transform.TransformData) (interface{}, error) {
+	configurations, ok := d.Value.(*[]network.InterfaceTapConfiguration)
+	if !ok || configurations == nil {
+		return nil, nil
+	}
+
+	var result []map[string]interface{}
+	for _, configuration := range *configurations {
+		objectMap := make(map[string]interface{})
+		if configuration.ID != nil {
+			objectMap["id"] = *configuration.ID
+			if i := strings.Index(strings.ToLower(*configuration.ID), "/tapconfigurations/"); i > 0 {
+				objectMap["networkInterfaceId"] = (*configuration.ID)[:i]
+			}
+		}
+		if configuration.Name != nil {
+			objectMap["name"] = *configuration.Name
+		}
+		if configuration.InterfaceTapConfigurationPropertiesFormat != nil && configuration.ProvisioningState != "" {
+			objectMap["provisioningState"] = configuration.ProvisioningState
+		}
+		result = append(result, objectMap)
+	}
+
+	return result, nil
+}
@@ -0,0 +1,151 @@
+package azure
+
+import (
+	"context"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/profiles/latest/web/mgmt/web"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+)
+
+//// TABLE DEFINITION
+
+func tableAzureWebAppConnectionString(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "azure_web_app_connection_string",
+		Description: "Azure Web App Connection String",
+		List: &plugin.ListConfig{
+			ParentHydrate: listAppServiceWebApps,
+			Hydrate:       listWebAppConnectionStrings,
+			KeyColumns: []*plugin.KeyColumn{
+				{
+					Name:    "app_name",
+					Require: plugin.Optional,
+				},
+			},
+		},
+		Columns: azureColumns([]*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name of the connection string.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "app_name",
+				Description: "The name of the web app the connection string belongs to.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "type",
+				Description: "Type of database. Possible values include: 'MySql', 'SQLServer', 'SQLAzure', 'Custom', 'NotificationHub', 'ServiceBus', 'EventHub', 'ApiHub', 'DocDb', 'RedisCache', 'PostgreSQL'.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "value",
+				Description: "The value of the connection string. Values of type Custom, SQLServer, SQLAzure and MySql are masked and only their length is shown.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.From(maskWebAppConnectionStringValue),
+			},
+			{
+				Name:        "is_key_vault_reference",
+				Description: "True if the value of the connection string is a Key Vault reference.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("Value").Transform(isKeyVaultReference),
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+
+			// Azure standard columns
+			{
+				Name:        "resource_group",
+				Description: ColumnDescriptionResourceGroup,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ResourceGroup").Transform(toLower),
+			},
+		}),
+	}
+}
+
+type WebAppConnectionStringInfo struct {
+	Name          string
+	Value         *string
+	Type          web.ConnectionStringType
+	AppName       *string
+	ResourceGroup *string
+}
+
+//// LIST FUNCTION
+
+func listWebAppConnectionStrings(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	data := h.Item.(web.Site)
+	appName := *data.Name
+	resourceGroupName := *data.ResourceGroup
+
+	// Restrict the API call for other apps if the app name is specified in the query parameter
+	if d.EqualsQualString("app_name") != "" && d.EqualsQualString("app_name") != appName {
+		return nil, nil
+	}
+
+	session, err := GetNewSession(ctx, d, "MANAGEMENT")
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_web_app_connection_string.listWebAppConnectionStrings", "session_error", err)
+		return nil, err
+	}
+	subscriptionID := session.SubscriptionID
+
+	webClient := web.NewAppsClientWithBaseURI(session.ResourceManagerEndpoint, subscriptionID)
+	webClient.Authorizer = session.Authorizer
+
+	op, err := webClient.ListConnectionStrings(ctx, resourceGroupName, appName)
+	if err != nil {
+		plugin.Logger(ctx).Error("azure_web_app_connection_string.listWebAppConnectionStrings", "api_error", err)
+		return nil, err
+	}
+
+	for name, pair := range op.Properties {
+		if pair == nil {
+			continue
+		}
+		d.StreamListItem(ctx, &WebAppConnectionStringInfo{
+			Name:          name,
+			Value:         pair.Value,
+			Type:          pair.Type,
+			AppName:       data.Name,
+			ResourceGroup: data.ResourceGroup,
+		})
+		// Check if context has been cancelled or if the limit has been hit (if specified)
+		// if there is a limit, it will return the number of rows required to reach this limit
+		if d.RowsRemaining(ctx) == 0 {
+			return nil, nil
+		}
+	}
+
+	return nil, nil
+}
+
+//// TRANSFORM FUNCTIONS
+
+// Connection strings of these types usually embed credentials, so only the
+// length of the value is exposed
+func maskWebAppConnectionStringValue(ctx context.Context, d *transform.TransformData) (interface{}, error) {
+	data := d.HydrateItem.(*WebAppConnectionStringInfo)
+	if data.Value == nil {
+		return nil, nil
+	}
+
+	switch data.Type {
+	case web.ConnectionStringTypeCustom, web.ConnectionStringTypeSQLServer, web.ConnectionStringTypeSQLAzure, web.ConnectionStringTypeMySQL:
+		return strings.Repeat("*", len(*data.Value)), nil
+	}
+
+	return *data.Value, nil
+}
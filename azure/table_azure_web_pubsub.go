@@ -0,0 +1,448 @@
+package azure
+
+import (
+	"context"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/profiles/latest/webpubsub/mgmt/webpubsub"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+)
+
+//// TABLE DEFINITION
+
+func tableAzureWebPubSub(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "azure_web_pubsub",
+		Description: "Azure Web PubSub",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.AllColumns([]string{"name", "resource_group"}),
+			Hydrate:    getWebPubSub,
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: isNotFoundError([]string{"ResourceNotFound", "ResourceGroupNotFound", "404"}),
+			},
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listWebPubSubs,
+		},
+		Columns: azureColumns([]*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name of the resource.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "id",
+				Description: "Fully qualified resource ID for the resource.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromGo(),
+			},
+			{
+				Name:        "type",
+				Description: "The type of the resource.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "provisioning_state",
+				Description: "Provisioning state of the resource. Possible values include: 'Unknown', 'Succeeded', 'Failed', 'Canceled', 'Running', 'Creating', 'Updating', 'Deleting', 'Moving'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties.ProvisioningState"),
+			},
+			{
+				Name:        "external_ip",
+				Description: "The publicly accessible IP of the Web PubSub service.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties.ExternalIP"),
+			},
+			{
+				Name:        "host_name",
+				Description: "FQDN of the Web PubSub service instance.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties.HostName"),
+			},
+			{
+				Name:        "host_name_prefix",
+				Description: "Prefix for the host name of the Web PubSub service.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties.HostNamePrefix"),
+			},
+			{
+				Name:        "public_port",
+				Description: "The publicly accessible port of the Web PubSub service which is designed for browser/client side usage.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("Properties.PublicPort"),
+			},
+			{
+				Name:        "server_port",
+				Description: "The publicly accessible port of the Web PubSub service which is designed for customer server side usage.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("Properties.ServerPort"),
+			},
+			{
+				Name:        "version",
+				Description: "Version of the Web PubSub resource.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties.Version"),
+			},
+			{
+				Name:        "sku_name",
+				Description: "The name of the SKU. Allowed values: Standard_S1, Free_F1.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Sku.Name"),
+			},
+			{
+				Name:        "sku_tier",
+				Description: "The tier of the SKU. Possible values include: 'Free', 'Basic', 'Standard', 'Premium'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Sku.Tier"),
+			},
+			{
+				Name:        "sku_capacity",
+				Description: "The number of units of the Web PubSub service.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("Sku.Capacity"),
+			},
+			{
+				Name:        "tls_client_cert_enabled",
+				Description: "Indicates whether a client certificate is requested during the TLS handshake.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("Properties.TLS.ClientCertEnabled"),
+			},
+			{
+				Name:        "public_network_access",
+				Description: "Indicates whether public network access is enabled. Possible values include: 'Enabled', 'Disabled'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Properties.PublicNetworkAccess"),
+			},
+			{
+				Name:        "disable_local_auth",
+				Description: "Indicates whether local authentication with access keys is disabled.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("Properties.DisableLocalAuth"),
+			},
+			{
+				Name:        "disable_aad_auth",
+				Description: "Indicates whether Azure Active Directory authentication is disabled.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("Properties.DisableAadAuth"),
+			},
+			{
+				Name:        "network_acls",
+				Description: "Network ACLs of the resource.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Properties.NetworkACLs"),
+			},
+			{
+				Name:        "private_endpoint_connections",
+				Description: "Private endpoint connections to the Web PubSub resource.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.From(extractWebPubSubPrivateEndpointConnections),
+			},
+			{
+				Name:        "hubs",
+				Description: "The hub settings of the Web PubSub service, including their event handlers and anonymous connect policy.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     listWebPubSubHubs,
+				Transform:   transform.FromValue(),
+			},
+			{
+				Name:        "shared_private_link_resources",
+				Description: "The list of shared private link resources of the Web PubSub service.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.From(extractWebPubSubSharedPrivateLinkResources),
+			},
+			{
+				Name:        "resource_log_configuration",
+				Description: "The resource log configuration of the Web PubSub service.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Properties.ResourceLogConfiguration"),
+			},
+			{
+				Name:        "live_trace_configuration",
+				Description: "The live trace configuration of the Web PubSub service.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Properties.LiveTraceConfiguration"),
+			},
+			{
+				Name:        "identity",
+				Description: "The managed identity of the Web PubSub service.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.From(extractWebPubSubIdentity),
+			},
+			{
+				Name:        "system_data",
+				Description: "Metadata pertaining to creation and last modification of the resource.",
+				Type:        proto.ColumnType_JSON,
+			},
+
+			// Steampipe standard columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+			{
+				Name:        "tags",
+				Description: ColumnDescriptionTags,
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "akas",
+				Description: ColumnDescriptionAkas,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("ID").Transform(idToAkas),
+			},
+
+			// Azure standard columns
+			{
+				Name:        "region",
+				Description: ColumnDescriptionRegion,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Location").Transform(toLower),
+			},
+			{
+				Name:        "resource_group",
+				Description: ColumnDescriptionResourceGroup,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ID").Transform(extractResourceGroupFromID),
+			},
+		}),
+	}
+}
+
+type WebPubSubPrivateEndpointConnections struct {
+	PrivateEndpointPropertyID         interface{}
+	PrivateLinkServiceConnectionState interface{}
+	ProvisioningState                 interface{}
+	GroupIds                          interface{}
+	ID                                *string
+	Name                              *string
+	Type                              *string
+}
+
+type WebPubSubSharedPrivateLinkResources struct {
+	GroupID               *string
+	PrivateLinkResourceID *string
+	ProvisioningState     interface{}
+	RequestMessage        *string
+	Status                interface{}
+	ID                    *string
+	Name                  *string
+	Type                  *string
+}
+
+//// LIST FUNCTION
+
+func listWebPubSubs(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	session, err := GetNewSession(ctx, d, "MANAGEMENT")
+	if err != nil {
+		return nil, err
+	}
+	subscriptionID := session.SubscriptionID
+
+	client := webpubsub.NewClientWithBaseURI(session.ResourceManagerEndpoint, subscriptionID)
+	client.Authorizer = session.Authorizer
+
+	result, err := client.ListBySubscription(ctx)
+	if err != nil {
+		plugin.Logger(ctx).Error("listWebPubSubs", "list", err)
+		return nil, err
+	}
+
+	for _, service := range result.Values() {
+		d.StreamListItem(ctx, service)
+		// Check if context has been cancelled or if the limit has been hit (if specified)
+		// if there is a limit, it will return the number of rows required to reach this limit
+		if d.RowsRemaining(ctx) == 0 {
+			return nil, nil
+		}
+	}
+
+	for result.NotDone() {
+		err = result.NextWithContext(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("listWebPubSubs", "list_paging", err)
+			return nil, err
+		}
+		for _, service := range result.Values() {
+			d.StreamListItem(ctx, service)
+			// Check if context has been cancelled or if the limit has been hit (if specified)
+			// if there is a limit, it will return the number of rows required to reach this limit
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, err
+}
+
+//// HYDRATE FUNCTIONS
+
+func getWebPubSub(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("getWebPubSub")
+
+	name := d.EqualsQuals["name"].GetStringValue()
+	resourceGroup := d.EqualsQuals["resource_group"].GetStringValue()
+
+	// Handle empty name or resourceGroup
+	if name == "" || resourceGroup == "" {
+		return nil, nil
+	}
+
+	session, err := GetNewSession(ctx, d, "MANAGEMENT")
+	if err != nil {
+		return nil, err
+	}
+	subscriptionID := session.SubscriptionID
+
+	client := webpubsub.NewClientWithBaseURI(session.ResourceManagerEndpoint, subscriptionID)
+	client.Authorizer = session.Authorizer
+
+	op, err := client.Get(ctx, resourceGroup, name)
+	if err != nil {
+		plugin.Logger(ctx).Error("getWebPubSub", "get", err)
+		return nil, err
+	}
+
+	// In some cases resource does not give any notFound error
+	// instead of notFound error, it returns empty data
+	if op.ID != nil {
+		return op, nil
+	}
+
+	return nil, nil
+}
+
+func listWebPubSubHubs(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	plugin.Logger(ctx).Trace("listWebPubSubHubs")
+	service := h.Item.(webpubsub.ResourceType)
+	resourceGroup := strings.Split(*service.ID, "/")[4]
+
+	session, err := GetNewSession(ctx, d, "MANAGEMENT")
+	if err != nil {
+		return nil, err
+	}
+	subscriptionID := session.SubscriptionID
+
+	client := webpubsub.NewHubsClientWithBaseURI(session.ResourceManagerEndpoint, subscriptionID)
+	client.Authorizer = session.Authorizer
+
+	result, err := client.List(ctx, resourceGroup, *service.Name)
+	if err != nil {
+		plugin.Logger(ctx).Error("listWebPubSubHubs", "list", err)
+		return nil, err
+	}
+
+	// If we return the API response directly, the output does not provide the
+	// ID, name and type of the hubs
+	var hubs []map[string]interface{}
+	for {
+		for _, hub := range result.Values() {
+			objectMap := make(map[string]interface{})
+			if hub.ID != nil {
+				objectMap["id"] = hub.ID
+			}
+			if hub.Name != nil {
+				objectMap["name"] = hub.Name
+			}
+			if hub.Type != nil {
+				objectMap["type"] = hub.Type
+			}
+			if hub.Properties != nil {
+				objectMap["properties"] = hub.Properties
+			}
+			hubs = append(hubs, objectMap)
+		}
+
+		if !result.NotDone() {
+			break
+		}
+		err = result.NextWithContext(ctx)
+		if err != nil {
+			plugin.Logger(ctx).Error("listWebPubSubHubs", "list_paging", err)
+			return nil, err
+		}
+	}
+
+	return hubs, nil
+}
+
+//// TRANSFORM FUNCTIONS
+
+// If we return the API response directly, the output will not provide all the properties of PrivateEndpointConnections
+func extractWebPubSubPrivateEndpointConnections(ctx context.Context, d *transform.TransformData) (interface{}, error) {
+	service := d.HydrateItem.(webpubsub.ResourceType)
+	info := []WebPubSubPrivateEndpointConnections{}
+
+	if service.Properties != nil && service.Properties.PrivateEndpointConnections != nil {
+		for _, connection := range *service.Properties.PrivateEndpointConnections {
+			properties := WebPubSubPrivateEndpointConnections{}
+			properties.ID = connection.ID
+			properties.Name = connection.Name
+			properties.Type = connection.Type
+			if connection.PrivateEndpointConnectionProperties != nil {
+				if connection.PrivateEndpointConnectionProperties.PrivateEndpoint != nil {
+					properties.PrivateEndpointPropertyID = connection.PrivateEndpointConnectionProperties.PrivateEndpoint.ID
+				}
+				properties.PrivateLinkServiceConnectionState = connection.PrivateEndpointConnectionProperties.PrivateLinkServiceConnectionState
+				properties.ProvisioningState = connection.PrivateEndpointConnectionProperties.ProvisioningState
+				properties.GroupIds = connection.PrivateEndpointConnectionProperties.GroupIds
+			}
+			info = append(info, properties)
+		}
+	}
+
+	return info, nil
+}
+
+// If we return the API response directly, the output will not provide all the properties of SharedPrivateLinkResources
+func extractWebPubSubSharedPrivateLinkResources(ctx context.Context, d *transform.TransformData) (interface{}, error) {
+	service := d.HydrateItem.(webpubsub.ResourceType)
+	info := []WebPubSubSharedPrivateLinkResources{}
+
+	if service.Properties != nil && service.Properties.SharedPrivateLinkResources != nil {
+		for _, resource := range *service.Properties.SharedPrivateLinkResources {
+			properties := WebPubSubSharedPrivateLinkResources{}
+			properties.ID = resource.ID
+			properties.Name = resource.Name
+			properties.Type = resource.Type
+			if resource.SharedPrivateLinkResourceProperties != nil {
+				properties.GroupID = resource.SharedPrivateLinkResourceProperties.GroupID
+				properties.PrivateLinkResourceID = resource.SharedPrivateLinkResourceProperties.PrivateLinkResourceID
+				properties.ProvisioningState = resource.SharedPrivateLinkResourceProperties.ProvisioningState
+				properties.RequestMessage = resource.SharedPrivateLinkResourceProperties.RequestMessage
+				properties.Status = resource.SharedPrivateLinkResourceProperties.Status
+			}
+			info = append(info, properties)
+		}
+	}
+
+	return info, nil
+}
+
+func extractWebPubSubIdentity(ctx context.Context, d *transform.TransformData) (interface{}, error) {
+	service := d.HydrateItem.(webpubsub.ResourceType)
+	if service.Identity == nil {
+		return nil, nil
+	}
+
+	objectMap := make(map[string]interface{})
+	if service.Identity.Type != "" {
+		objectMap["type"] = service.Identity.Type
+	}
+	if service.Identity.PrincipalID != nil {
+		objectMap["principalId"] = service.Identity.PrincipalID
+	}
+	if service.Identity.TenantID != nil {
+		objectMap["tenantId"] = service.Identity.TenantID
+	}
+	if service.Identity.UserAssignedIdentities != nil {
+		objectMap["userAssignedIdentities"] = service.Identity.UserAssignedIdentities
+	}
+
+	return objectMap, nil
+}
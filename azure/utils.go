@@ -131,3 +131,23 @@ func formatRegion(ctx context.Context, d *transform.TransformData) (interface{},
 	region := strings.ReplaceAll(valStr, " ", "")
 	return region, nil
 }
+
+// Check whether the value is a Key Vault reference (i.e. '@Microsoft.KeyVault(SecretUri=...)')
+func isKeyVaultReference(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	valStr := types.SafeString(d.Value)
+	return strings.HasPrefix(valStr, "@Microsoft.KeyVault"), nil
+}
+
+// Extract a property from the properties of a generic resource, which are returned as a map.
+// The transform parameter is the property path (i.e. 'metrics.prometheusQueryEndpoint')
+func extractGenericResourceProperty(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	value := d.Value
+	for _, key := range strings.Split(d.Param.(string), ".") {
+		properties, ok := value.(map[string]interface{})
+		if !ok {
+			return nil, nil
+		}
+		value = properties[key]
+	}
+	return value, nil
+}